@@ -0,0 +1,231 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"net"
+	"strings"
+)
+
+const (
+	acPermissionAllow = "ALLOW"
+	acPermissionDeny  = "DENY"
+
+	// acAddressAny is the special value of the address of an access control entry, which matches any client.
+	acAddressAny = "Any"
+)
+
+var tsigKeyAlgorithms = []string{"HMAC-MD5", "HMAC-SHA256"}
+
+// accessControlListSchema returns the schema of an ordered list of access control entries,
+// as used by named ACLs and by the ACL-related fields of DNS views and zones.
+// Every entry is either an address (IP address, network or 'Any'), a TSIG key or a reference to a named ACL.
+func accessControlListSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The IPv4/IPv6 address or network in CIDR format the entry applies to, or 'Any'.",
+				},
+				"named_acl": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the named ACL the entry refers to.",
+				},
+				"permission": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      acPermissionAllow,
+					ValidateFunc: validation.StringInSlice([]string{acPermissionAllow, acPermissionDeny}, false),
+					Description:  "The permission of the entry: ALLOW or DENY.",
+				},
+				"tsig_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The TSIG key the entry applies to.",
+				},
+				"tsig_key_alg": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(tsigKeyAlgorithms, false),
+					Description:  "The algorithm of the TSIG key: HMAC-MD5 or HMAC-SHA256.",
+				},
+				"tsig_key_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the TSIG key.",
+				},
+			},
+		},
+	}
+}
+
+// isAccessControlAddress checks whether the value is an address of an access control entry,
+// as opposed to the name of a named ACL.
+func isAccessControlAddress(value string) bool {
+	if value == "" || strings.EqualFold(value, acAddressAny) {
+		return true
+	}
+	if net.ParseIP(value) != nil {
+		return true
+	}
+	_, _, err := net.ParseCIDR(value)
+
+	return err == nil
+}
+
+func expandAccessControlList(field string, entries []interface{}) ([]*ibclient.Addressac, error) {
+	res := make([]*ibclient.Addressac, 0, len(entries))
+	for i, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("entry %d of '%s' must not be empty", i, field)
+		}
+
+		address := entry["address"].(string)
+		namedACL := entry["named_acl"].(string)
+		tsigKey := entry["tsig_key"].(string)
+
+		set := 0
+		for _, v := range []string{address, namedACL, tsigKey} {
+			if v != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf(
+				"exactly one of 'address', 'named_acl' and 'tsig_key' must be specified for entry %d of '%s'", i, field)
+		}
+		if address != "" && !isAccessControlAddress(address) {
+			return nil, fmt.Errorf(
+				"'%s' in entry %d of '%s' is neither an IP address, a network nor '%s'; use 'named_acl' to refer to a named ACL",
+				address, i, field, acAddressAny)
+		}
+		if namedACL != "" && isAccessControlAddress(namedACL) {
+			return nil, fmt.Errorf("'%s' in entry %d of '%s' is not a valid name of a named ACL", namedACL, i, field)
+		}
+
+		ac := &ibclient.Addressac{
+			Permission: entry["permission"].(string),
+		}
+		switch {
+		case tsigKey != "":
+			ac.TsigKey = tsigKey
+			ac.TsigKeyAlg = entry["tsig_key_alg"].(string)
+			ac.TsigKeyName = entry["tsig_key_name"].(string)
+			ac.UseTsigKeyName = ac.TsigKeyName != ""
+		case namedACL != "":
+			// NIOS refers to a named ACL by its name in place of the address.
+			ac.Address = namedACL
+		default:
+			ac.Address = address
+		}
+		res = append(res, ac)
+	}
+
+	return res, nil
+}
+
+func flattenAccessControlList(acl []*ibclient.Addressac) []interface{} {
+	res := make([]interface{}, 0, len(acl))
+	for _, ac := range acl {
+		if ac == nil {
+			continue
+		}
+		entry := map[string]interface{}{
+			"address":       "",
+			"named_acl":     "",
+			"permission":    ac.Permission,
+			"tsig_key":      ac.TsigKey,
+			"tsig_key_alg":  ac.TsigKeyAlg,
+			"tsig_key_name": ac.TsigKeyName,
+		}
+		if ac.TsigKey == "" {
+			if isAccessControlAddress(ac.Address) {
+				entry["address"] = ac.Address
+			} else {
+				entry["named_acl"] = ac.Address
+			}
+		}
+		if entry["permission"] == "" {
+			entry["permission"] = acPermissionAllow
+		}
+		res = append(res, entry)
+	}
+
+	return res
+}
+
+// getAccessControlLists fetches the given access control fields of a NIOS object.
+// The go-client's object managers use fixed sets of return fields, which do not include them.
+func getAccessControlLists(
+	conn ibclient.IBConnector, objType, ref string, fields []string) (map[string][]*ibclient.Addressac, error) {
+
+	obj := newWapiRawObject(objType, nil)
+	obj.SetReturnFields(fields)
+	var res map[string]json.RawMessage
+	if err := conn.GetObject(obj, ref, ibclient.NewQueryParams(false, nil), &res); err != nil {
+		return nil, err
+	}
+
+	acls := make(map[string][]*ibclient.Addressac, len(fields))
+	for _, f := range fields {
+		var acl []*ibclient.Addressac
+		if raw, ok := res[f]; ok {
+			if err := json.Unmarshal(raw, &acl); err != nil {
+				return nil, fmt.Errorf("failed to parse '%s' of '%s': %w", f, ref, err)
+			}
+		}
+		acls[f] = acl
+	}
+
+	return acls, nil
+}
+
+// readAccessControlLists sets the given access control fields of the resource from the NIOS object.
+func readAccessControlLists(d *schema.ResourceData, m interface{}, objType, ref string, fields []string) error {
+	acls, err := getAccessControlLists(m.(ibclient.IBConnector), objType, ref, fields)
+	if err != nil {
+		return fmt.Errorf("failed to read access control lists of '%s': %w", ref, err)
+	}
+	for _, f := range fields {
+		if err = d.Set(f, flattenAccessControlList(acls[f])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// changedAccessControlLists returns the changed access control fields of the resource as WAPI fields.
+// All the fields are returned on creation of the object (isNew), if they are not empty.
+// If useFlags is true, the corresponding 'use_' flags are returned as well, so that an empty list
+// means the value inherited from the upper level (grid or member).
+func changedAccessControlLists(d *schema.ResourceData, fields []string, isNew, useFlags bool) (map[string]interface{}, error) {
+	changed := make(map[string]interface{})
+	for _, f := range fields {
+		entries := d.Get(f).([]interface{})
+		if isNew && len(entries) == 0 || !isNew && !d.HasChange(f) {
+			continue
+		}
+		acl, err := expandAccessControlList(f, entries)
+		if err != nil {
+			return nil, err
+		}
+		changed[f] = acl
+		if useFlags {
+			changed["use_"+f] = len(acl) > 0
+		}
+	}
+
+	return changed, nil
+}
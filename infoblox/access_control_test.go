@@ -0,0 +1,104 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"reflect"
+	"testing"
+)
+
+func accessControlEntry(address, namedACL, permission, tsigKey string) map[string]interface{} {
+	return map[string]interface{}{
+		"address":       address,
+		"named_acl":     namedACL,
+		"permission":    permission,
+		"tsig_key":      tsigKey,
+		"tsig_key_alg":  "",
+		"tsig_key_name": "",
+	}
+}
+
+func TestExpandAccessControlList(t *testing.T) {
+	acl, err := expandAccessControlList("match_clients", []interface{}{
+		accessControlEntry("10.0.0.0/24", "", "ALLOW", ""),
+		accessControlEntry("", "internal_clients", "DENY", ""),
+		accessControlEntry("Any", "", "DENY", ""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []*ibclient.Addressac{
+		{Address: "10.0.0.0/24", Permission: "ALLOW"},
+		{Address: "internal_clients", Permission: "DENY"},
+		{Address: "Any", Permission: "DENY"},
+	}
+	if !reflect.DeepEqual(acl, expected) {
+		t.Errorf("unexpected access control list: %+v", acl)
+	}
+
+	invalid := [][]interface{}{
+		{accessControlEntry("10.0.0.1", "internal_clients", "ALLOW", "")},
+		{accessControlEntry("", "", "ALLOW", "")},
+		{accessControlEntry("internal_clients", "", "ALLOW", "")},
+		{accessControlEntry("", "10.0.0.1", "ALLOW", "")},
+	}
+	for _, entries := range invalid {
+		if _, err = expandAccessControlList("match_clients", entries); err == nil {
+			t.Errorf("an error is expected for %+v", entries)
+		}
+	}
+}
+
+func TestFlattenAccessControlList(t *testing.T) {
+	entries := flattenAccessControlList([]*ibclient.Addressac{
+		{Address: "2001:db8::/64", Permission: "ALLOW"},
+		{Address: "internal_clients", Permission: "DENY"},
+		{TsigKey: "X4oRe92t54I+T98NdQpV2w==", TsigKeyAlg: "HMAC-SHA256", TsigKeyName: "key1", UseTsigKeyName: true},
+	})
+	expected := []interface{}{
+		accessControlEntry("2001:db8::/64", "", "ALLOW", ""),
+		accessControlEntry("", "internal_clients", "DENY", ""),
+		map[string]interface{}{
+			"address":       "",
+			"named_acl":     "",
+			"permission":    "ALLOW",
+			"tsig_key":      "X4oRe92t54I+T98NdQpV2w==",
+			"tsig_key_alg":  "HMAC-SHA256",
+			"tsig_key_name": "key1",
+		},
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Errorf("unexpected entries: %+v", entries)
+	}
+}
+
+func TestWithAccessControlLists(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceZoneAuth().Schema, map[string]interface{}{
+		"fqdn":        "test.com",
+		"allow_query": []interface{}{accessControlEntry("10.0.0.0/24", "", "ALLOW", "")},
+	})
+	acls, err := changedAccessControlLists(d, zoneAuthAccessControlFields, true, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	zone := &ibclient.ZoneAuth{Fqdn: "test.com"}
+	obj, err := withWapiFields(zone, acls)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := `{"allow_query":[{"address":"10.0.0.0/24","permission":"ALLOW"}],` +
+		`"extattrs":{},"fqdn":"test.com","ns_group":null,"use_allow_query":true}`
+	if obj.ObjectType() != "zone_auth" || string(data) != expected {
+		t.Errorf("expected the ACLs to be sent along with the zone, got '%s' %s", obj.ObjectType(), data)
+	}
+
+	if obj, err = withWapiFields(zone, nil); err != nil || obj != zone {
+		t.Errorf("expected the zone to be sent as is, got %v, %v", obj, err)
+	}
+}
@@ -0,0 +1,117 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// withAdoptExisting makes the resource take an existing object under management, if the creation of the object
+// fails because of a conflict with it and 'adopt_existing' is enabled at the provider level.
+// The existing object is looked up by its natural key, which maps the arguments of the resource
+// to the search fields of the WAPI object type. The adopted object is updated with the rest of the arguments
+// right away, so that it matches the configuration after the same apply.
+func withAdoptExisting(r *schema.Resource, objType string, naturalKey map[string]string) *schema.Resource {
+	create, update := r.CreateContext, r.UpdateContext
+
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := create(ctx, d, m)
+		if !adoptExistingFromMeta(m) || !hasWapiError(diags, wapiErrorsFromMeta(m), errWapiConflict) {
+			return diags
+		}
+
+		adopted, err := adoptExistingObject(m.(ibclient.IBConnector), d, r.Schema, objType, naturalKey)
+		if err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Adoption of the existing '%s' object failed", objType),
+				Detail:   err.Error(),
+			})
+		}
+		if !adopted {
+			return diags
+		}
+
+		return update(ctx, d, m)
+	}
+
+	return r
+}
+
+// adoptExistingObject looks up the object identified by the natural key, checks that it is not managed
+// by another resource, and marks it with a new internal ID of the resource. It returns false
+// if the arguments of the natural key are not known or no object matches them.
+func adoptExistingObject(
+	conn ibclient.IBConnector, d *schema.ResourceData, s map[string]*schema.Schema,
+	objType string, naturalKey map[string]string) (bool, error) {
+
+	searchFields := make(map[string]string, len(naturalKey))
+	for attr, field := range naturalKey {
+		value := d.Get(attr)
+		// The value is not known, for example an address to be allocated dynamically.
+		if str, ok := value.(string); ok && str == "" {
+			return false, nil
+		}
+		searchFields[field] = fmt.Sprint(value)
+	}
+
+	obj := newWapiRawObject(objType, nil)
+	obj.SetReturnFields([]string{"extattrs"})
+	var found []map[string]interface{}
+	err := conn.GetObject(obj, "", ibclient.NewQueryParams(false, searchFields), &found)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting the existing object failed: %w", err)
+	}
+	switch {
+	case len(found) == 0:
+		return false, nil
+	case len(found) > 1:
+		return false, fmt.Errorf("%d existing objects match %v", len(found), searchFields)
+	}
+
+	ref, _ := found[0]["_ref"].(string)
+	if owner := internalIdOfWapiObject(found[0]); owner != "" {
+		return false, fmt.Errorf(
+			"the existing object '%s' is managed by another Terraform resource with internal ID '%s'", ref, owner)
+	}
+
+	internalId := generateInternalId().String()
+	fields := map[string]interface{}{
+		"extattrs+": map[string]interface{}{
+			eaNameForInternalId: map[string]interface{}{"value": internalId},
+		},
+	}
+	newRef, err := conn.UpdateObject(newWapiRawObject(objType, fields), ref)
+	if err != nil {
+		return false, fmt.Errorf("setting the internal ID of the existing object '%s' failed: %w", ref, err)
+	}
+	ref = newRef
+
+	d.SetId(ref)
+	for name, value := range map[string]string{"ref": ref, "internal_id": internalId} {
+		if _, ok := s[name]; !ok {
+			continue
+		}
+		if err = d.Set(name, value); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// internalIdOfWapiObject returns the value of the internal ID extensible attribute of the object, if any.
+func internalIdOfWapiObject(obj map[string]interface{}) string {
+	extAttrs, _ := obj["extattrs"].(map[string]interface{})
+	ea, _ := extAttrs[eaNameForInternalId].(map[string]interface{})
+	if ea == nil {
+		return ""
+	}
+
+	return fmt.Sprint(ea["value"])
+}
@@ -0,0 +1,131 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// testAdoptConnector returns a fixed list of objects and records the updates.
+type testAdoptConnector struct {
+	ibclient.IBConnector
+
+	objects []map[string]interface{}
+	url     string
+	updates []string
+}
+
+func (c *testAdoptConnector) GetObject(
+	obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+
+	wrb := &ibclient.WapiRequestBuilder{}
+	wrb.Init(ibclient.HostConfig{Host: "localhost", Version: "2.12.3"}, ibclient.AuthConfig{})
+	c.url = wrb.BuildUrl(ibclient.GET, obj.ObjectType(), ref, obj.ReturnFields(), qp)
+	data, err := json.Marshal(c.objects)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, res)
+}
+
+func (c *testAdoptConnector) UpdateObject(obj ibclient.IBObject, ref string) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	c.updates = append(c.updates, ref+" "+string(data))
+
+	return ref, nil
+}
+
+func TestAdoptExistingObject(t *testing.T) {
+	r := resourceARecord()
+	raw := map[string]interface{}{"fqdn": "a.test.com", "ip_addr": "10.0.0.1", "dns_view": "default"}
+	ref := "record:a/ZG5zLmJpbmRfYSQuX2RlZmF1bHQuY29tLnRlc3QsYSwxMC4wLjAuMQ:a.test.com/default"
+
+	conn := &testAdoptConnector{objects: []map[string]interface{}{{"_ref": ref}}}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	adopted, err := adoptExistingObject(conn, d, r.Schema, "record:a", aRecordNaturalKey)
+	if err != nil || !adopted {
+		t.Fatalf("expected the object to be adopted, got %t, %v", adopted, err)
+	}
+	if d.Id() != ref || d.Get("ref") != ref || d.Get("internal_id") == "" {
+		t.Errorf("expected the ID, the reference and the internal ID to be set, got '%s', '%s', '%s'",
+			d.Id(), d.Get("ref"), d.Get("internal_id"))
+	}
+	expected := fmt.Sprintf(`%s {"extattrs+":{"Terraform Internal ID":{"value":"%s"}}}`, ref, d.Get("internal_id"))
+	if len(conn.updates) != 1 || conn.updates[0] != expected {
+		t.Errorf("expected the internal ID to be added to the object, got %v", conn.updates)
+	}
+	if url := conn.url; !regexp.MustCompile(`name=a\.test\.com`).MatchString(url) ||
+		!regexp.MustCompile(`ipv4addr=10\.0\.0\.1`).MatchString(url) || !regexp.MustCompile(`view=default`).MatchString(url) {
+		t.Errorf("expected the object to be searched by its natural key, got %s", url)
+	}
+
+	conn = &testAdoptConnector{objects: []map[string]interface{}{{
+		"_ref":     ref,
+		"extattrs": map[string]interface{}{eaNameForInternalId: map[string]interface{}{"value": "other-id"}},
+	}}}
+	d = schema.TestResourceDataRaw(t, r.Schema, raw)
+	_, err = adoptExistingObject(conn, d, r.Schema, "record:a", aRecordNaturalKey)
+	if err == nil || !regexp.MustCompile("managed by another Terraform resource with internal ID 'other-id'").MatchString(err.Error()) {
+		t.Errorf("expected the object managed by another resource not to be adopted, got %v", err)
+	}
+	if len(conn.updates) != 0 || d.Id() != "" {
+		t.Errorf("expected the object not to be changed, got %v", conn.updates)
+	}
+
+	conn = &testAdoptConnector{objects: []map[string]interface{}{{"_ref": ref}}}
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"fqdn": "a.test.com", "cidr": "10.0.0.0/24"})
+	if adopted, err = adoptExistingObject(conn, d, r.Schema, "record:a", aRecordNaturalKey); err != nil || adopted {
+		t.Errorf("expected the object with an unknown address not to be adopted, got %t, %v", adopted, err)
+	}
+}
+
+func TestWithAdoptExisting(t *testing.T) {
+	ref := "record:a/ZG5zLmJpbmRfYSQuX2RlZmF1bHQuY29tLnRlc3QsYSwxMC4wLjAuMQ:a.test.com/default"
+	conflictErr := newWapiError(http.StatusBadRequest,
+		[]byte(`{"Error": "AdmConDataError", "code": "Client.Ibap.Data.Conflict", "text": "The record already exists."}`))
+
+	var updated []string
+	r := resourceARecord()
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(fmt.Errorf("creation of A-record failed: %w", conflictErr))
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		updated = append(updated, d.Id()+" "+d.Get("comment").(string))
+		return nil
+	}
+	r = withAdoptExisting(r, "record:a", aRecordNaturalKey)
+
+	raw := map[string]interface{}{"fqdn": "a.test.com", "ip_addr": "10.0.0.1", "dns_view": "default", "comment": "adopted"}
+	conn := &testAdoptConnector{objects: []map[string]interface{}{{"_ref": ref}}}
+	meta := &providerMeta{
+		IBConnector:   conn,
+		adoptExisting: true,
+		connector:     &wapiConnector{wapiErrors: &[]*wapiError{conflictErr}},
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("expected the existing object to be adopted, got %v", diags)
+	}
+	if len(updated) != 1 || updated[0] != ref+" adopted" {
+		t.Errorf("expected the adopted object to be updated with the arguments, got %v", updated)
+	}
+
+	meta.adoptExisting = false
+	updated = nil
+	d = schema.TestResourceDataRaw(t, r.Schema, raw)
+	if diags := r.CreateContext(context.Background(), d, meta); !diags.HasError() || len(updated) != 0 {
+		t.Errorf("expected the conflict to be reported when the adoption is disabled, got %v, %v", diags, updated)
+	}
+}
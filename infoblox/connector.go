@@ -0,0 +1,198 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"net/http"
+)
+
+// wapiConnector is the connector of the provider. It is created once, when the provider is configured,
+// and sends every WAPI request within the context it is bound to by withContext, so that the cancellation
+// of Terraform and the timeouts of the operations cancel the requests in flight. It follows the go-client's
+// connector, which has no way to pass a context to the requests.
+type wapiConnector struct {
+	requestBuilder ibclient.HttpRequestBuilder
+	requestor      ibclient.HttpRequestor
+
+	ctx context.Context
+	// The WAPI errors received within the context, used to add the details to the diagnostics of the operation.
+	wapiErrors *[]*wapiError
+}
+
+func newWapiConnector(
+	hostConfig ibclient.HostConfig, authConfig ibclient.AuthConfig, transportConfig ibclient.TransportConfig,
+	requestBuilder ibclient.HttpRequestBuilder, requestor ibclient.HttpRequestor) *wapiConnector {
+
+	requestBuilder.Init(hostConfig, authConfig)
+	requestor.Init(authConfig, transportConfig)
+
+	return &wapiConnector{
+		requestBuilder: requestBuilder,
+		requestor:      requestor,
+		ctx:            context.Background(),
+	}
+}
+
+// withContext returns a copy of the connector, which sends the requests within the context
+// and keeps the WAPI errors received. The copy shares the request builder and the requestor,
+// and thus the HTTP client and the session, with the original connector.
+func (c *wapiConnector) withContext(ctx context.Context) *wapiConnector {
+	res := *c
+	res.ctx = ctx
+	res.wapiErrors = &[]*wapiError{}
+
+	return &res
+}
+
+func (c *wapiConnector) sendRequest(req *http.Request) ([]byte, error) {
+	res, err := c.requestor.SendRequest(req.WithContext(c.ctx))
+	var wErr *wapiError
+	if c.wapiErrors != nil && errors.As(err, &wErr) {
+		*c.wapiErrors = append(*c.wapiErrors, wErr)
+	}
+
+	return res, err
+}
+
+// receivedWapiErrors returns the WAPI errors received within the context of the connector.
+func (c *wapiConnector) receivedWapiErrors() []*wapiError {
+	if c.wapiErrors == nil {
+		return nil
+	}
+
+	return *c.wapiErrors
+}
+
+func (c *wapiConnector) makeRequest(
+	t ibclient.RequestType, obj ibclient.IBObject, ref string, queryParams *ibclient.QueryParams) ([]byte, error) {
+
+	req, err := c.requestBuilder.BuildRequest(t, obj, ref, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.sendRequest(req)
+	if err != nil && t == ibclient.GET && queryParams != nil && c.ctx.Err() == nil {
+		return c.proxySearch(obj, ref, queryParams)
+	}
+
+	return res, err
+}
+
+// proxySearch repeats a search on the Grid Master, like the go-client's connector does
+// for the searches which fail or find nothing on the member the request is sent to.
+func (c *wapiConnector) proxySearch(obj ibclient.IBObject, ref string, queryParams *ibclient.QueryParams) ([]byte, error) {
+	req, err := c.requestBuilder.BuildRequest(ibclient.GET, obj, ref, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	query := req.URL.Query()
+	query.Set("_proxy_search", "GM")
+	req.URL.RawQuery = query.Encode()
+
+	return c.sendRequest(req)
+}
+
+func (c *wapiConnector) CreateObject(obj ibclient.IBObject) (string, error) {
+	resp, err := c.makeRequest(ibclient.CREATE, obj, "", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var ref string
+	if err = json.Unmarshal(resp, &ref); err != nil {
+		return "", fmt.Errorf("cannot parse the reference of the created object: %w", err)
+	}
+
+	return ref, nil
+}
+
+func (c *wapiConnector) GetObject(
+	obj ibclient.IBObject, ref string, queryParams *ibclient.QueryParams, res interface{}) error {
+
+	resp, err := c.makeRequest(ibclient.GET, obj, ref, queryParams)
+	if err != nil {
+		return err
+	}
+	if isEmptyWapiResult(resp) {
+		if queryParams == nil {
+			return ibclient.NewNotFoundError("requested object not found")
+		}
+		if resp, err = c.proxySearch(obj, ref, queryParams); err != nil {
+			return err
+		}
+		if isEmptyWapiResult(resp) {
+			return ibclient.NewNotFoundError("not found")
+		}
+	}
+
+	return json.Unmarshal(resp, res)
+}
+
+func (c *wapiConnector) DeleteObject(ref string) (string, error) {
+	resp, err := c.makeRequest(ibclient.DELETE, nil, ref, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var refRes string
+	if err = json.Unmarshal(resp, &refRes); err != nil {
+		return "", fmt.Errorf("cannot parse the reference of the deleted object: %w", err)
+	}
+
+	return refRes, nil
+}
+
+func (c *wapiConnector) UpdateObject(obj ibclient.IBObject, ref string) (string, error) {
+	resp, err := c.makeRequest(ibclient.UPDATE, obj, ref, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var refRes string
+	if err = json.Unmarshal(resp, &refRes); err != nil {
+		return "", fmt.Errorf("cannot parse the reference of the updated object: %w", err)
+	}
+
+	return refRes, nil
+}
+
+// callFunction sends a POST request with the '_function' argument, which the go-client's connector does not support.
+func (c *wapiConnector) callFunction(ref, function string, args map[string]interface{}, res interface{}) error {
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+	req, err := c.requestBuilder.BuildRequest(ibclient.CREATE, newWapiRawObject("", args), ref, nil)
+	if err != nil {
+		return err
+	}
+	query := req.URL.Query()
+	query.Set("_function", function)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return err
+	}
+	if res != nil && len(resp) > 0 {
+		if err = json.Unmarshal(resp, res); err != nil {
+			return fmt.Errorf("failed to parse the result of '%s' function: %w", function, err)
+		}
+	}
+
+	return nil
+}
+
+// isEmptyWapiResult returns true if the response of a search has no objects.
+func isEmptyWapiResult(resp []byte) bool {
+	var res interface{}
+	if len(resp) == 0 || json.Unmarshal(resp, &res) != nil {
+		return len(resp) == 0
+	}
+	list, ok := res.([]interface{})
+
+	return ok && len(list) == 0
+}
@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceARecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceARecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -66,11 +64,12 @@ func dataSourceARecord() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the A-record, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "A records")))
 }
 
 func dataSourceARecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -82,18 +81,9 @@ func dataSourceARecordRead(ctx context.Context, d *schema.ResourceData, m interf
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "comment", "zone", "ttl"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.RecordA
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.RecordA](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.RecordA{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting A Record failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting A Record failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -108,14 +98,10 @@ func dataSourceARecordRead(ctx context.Context, d *schema.ResourceData, m interf
 		results = append(results, recordaFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -133,10 +119,11 @@ func flattenRecordA(recorda ibclient.RecordA) (map[string]interface{}, error) {
 	}
 
 	res := map[string]interface{}{
-		"id":        recorda.Ref,
-		"zone":      recorda.Zone,
-		"dns_view":  recorda.View,
-		"ext_attrs": string(ea),
+		"id":                    recorda.Ref,
+		"zone":                  recorda.Zone,
+		"dns_view":              recorda.View,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if recorda.Ipv4Addr != nil {
@@ -5,15 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func dataSourceAAAARecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceAAAARecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -67,11 +65,12 @@ func dataSourceAAAARecord() *schema.Resource {
 							Computed:    true,
 							Description: "The Extensible attributes of the AAAA-record",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "AAAA records")))
 }
 
 func dataSourceAAAARecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -83,18 +82,9 @@ func dataSourceAAAARecordRead(ctx context.Context, d *schema.ResourceData, m int
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "zone", "comment", "ttl"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.RecordAAAA
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.RecordAAAA](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.RecordAAAA{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting AAAA Record failed : %s", err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting AAAA Record failed : %s", err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -109,14 +99,10 @@ func dataSourceAAAARecordRead(ctx context.Context, d *schema.ResourceData, m int
 		results = append(results, qarecordFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -133,10 +119,11 @@ func flattenRecordAAAA(qarecord ibclient.RecordAAAA) (map[string]interface{}, er
 	}
 
 	res := map[string]interface{}{
-		"id":        qarecord.Ref,
-		"dns_view":  qarecord.View,
-		"zone":      qarecord.Zone,
-		"ext_attrs": string(ea),
+		"id":                    qarecord.Ref,
+		"dns_view":              qarecord.View,
+		"zone":                  qarecord.Zone,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if qarecord.Ipv6Addr != nil {
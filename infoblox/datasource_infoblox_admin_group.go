@@ -0,0 +1,99 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceAdminGroup() *schema.Resource {
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
+		ReadContext: dataSourceAdminGroupRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of admin groups matching filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the admin group.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comment for the admin group.",
+						},
+						"superuser": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the admin group is a superuser group.",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the admin roles assigned to the admin group.",
+						},
+						"access_method": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The access methods the members of the admin group may use.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the admin group is disabled.",
+						},
+						"email_addresses": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The e-mail addresses of the admin group.",
+						},
+					},
+				},
+			},
+		},
+	}, "admin groups")))
+}
+
+func dataSourceAdminGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	obj := &ibclient.Admingroup{}
+	obj.SetReturnFields(adminGroupReturnFields)
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	res, err := getPagedObjects[ibclient.Admingroup](d, connector, obj, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting admin group failed: %w", err))
+	}
+
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		results = append(results, flattenAdminGroup(r))
+	}
+
+	if err = setDataSourceResults(d, results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
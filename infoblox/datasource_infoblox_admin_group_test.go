@@ -0,0 +1,34 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceAdminGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_admin_group" "test_group" {
+						name = "tf_acc_test_ds_group"
+						comment = "admin group for data source test"
+						superuser = true
+					}
+					data "infoblox_admin_group" "acctest" {
+						filters = {
+							name = "tf_acc_test_ds_group"
+						}
+						depends_on = [infoblox_admin_group.test_group]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_admin_group.acctest", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_admin_group.acctest", "results.0.comment", "admin group for data source test"),
+					resource.TestCheckResourceAttr("data.infoblox_admin_group.acctest", "results.0.superuser", "true"),
+				),
+			},
+		},
+	})
+}
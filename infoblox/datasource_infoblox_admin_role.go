@@ -0,0 +1,76 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceAdminRole() *schema.Resource {
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
+		ReadContext: dataSourceAdminRoleRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of admin roles matching filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the admin role.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comment for the admin role.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the admin role is disabled.",
+						},
+					},
+				},
+			},
+		},
+	}, "admin roles")))
+}
+
+func dataSourceAdminRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	obj := &ibclient.Adminrole{}
+	obj.SetReturnFields(adminRoleReturnFields)
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	res, err := getPagedObjects[ibclient.Adminrole](d, connector, obj, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting admin role failed: %w", err))
+	}
+
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		results = append(results, flattenAdminRole(r))
+	}
+
+	if err = setDataSourceResults(d, results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,32 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceAdminRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_admin_role" "test_role" {
+						name = "tf_acc_test_ds_role"
+						comment = "admin role for data source test"
+					}
+					data "infoblox_admin_role" "acctest" {
+						filters = {
+							name = "tf_acc_test_ds_role"
+						}
+						depends_on = [infoblox_admin_role.test_role]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_admin_role.acctest", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_admin_role.acctest", "results.0.comment", "admin role for data source test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceAdminUser() *schema.Resource {
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
+		ReadContext: dataSourceAdminUserRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of admin users matching filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the admin user.",
+						},
+						"auth_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The authentication type of the admin user.",
+						},
+						"admin_groups": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the admin groups the admin user belongs to.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comment for the admin user.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The e-mail address of the admin user.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the admin user is disabled.",
+						},
+					},
+				},
+			},
+		},
+	}, "admin users")))
+}
+
+func dataSourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	obj := &ibclient.Adminuser{}
+	obj.SetReturnFields(adminUserReturnFields)
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	res, err := getPagedObjects[ibclient.Adminuser](d, connector, obj, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting admin user failed: %w", err))
+	}
+
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		results = append(results, flattenAdminUser(r))
+	}
+
+	if err = setDataSourceResults(d, results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
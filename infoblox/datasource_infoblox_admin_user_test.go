@@ -0,0 +1,36 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceAdminUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_admin_group" "test_group" {
+						name = "tf_acc_test_ds_user_group"
+					}
+					resource "infoblox_admin_user" "test_user" {
+						name = "tf_acc_test_ds_user"
+						password = "Infoblox@123"
+						admin_groups = [infoblox_admin_group.test_group.name]
+					}
+					data "infoblox_admin_user" "acctest" {
+						filters = {
+							name = "tf_acc_test_ds_user"
+						}
+						depends_on = [infoblox_admin_user.test_user]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_admin_user.acctest", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_admin_user.acctest", "results.0.admin_groups.0", "tf_acc_test_ds_user_group"),
+				),
+			},
+		},
+	})
+}
@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceAliasRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: datasourceAliasRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -70,6 +68,7 @@ func dataSourceAliasRecord() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the  Alias Record to be added/updated, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"creator": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -99,7 +98,7 @@ func dataSourceAliasRecord() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "alias records")))
 }
 
 func datasourceAliasRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -109,10 +108,10 @@ func datasourceAliasRecordRead(ctx context.Context, d *schema.ResourceData, m in
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, _ := objMgr.GetAllAliasRecord(qp)
+	res, err := getPagedObjects[ibclient.RecordAlias](d, connector, ibclient.NewEmptyAliasRecord(), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting Alias Record failed with filters %v: %s", filters, err.Error()))
+	}
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
 	//       (avoiding additional layer of keys ("value" key)
 	results := make([]interface{}, 0, len(res))
@@ -124,13 +123,9 @@ func datasourceAliasRecordRead(ctx context.Context, d *schema.ResourceData, m in
 		results = append(results, aliasRecord)
 	}
 
-	err := d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
-
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
 	return diags
 }
 
@@ -148,16 +143,17 @@ func flattenAliasRecord(aliasRecord ibclient.RecordAlias) (interface{}, error) {
 	}
 
 	res := map[string]interface{}{
-		"id":              aliasRecord.Ref,
-		"name":            aliasRecord.Name,
-		"ext_attrs":       string(ea),
-		"target_name":     aliasRecord.TargetName,
-		"target_type":     aliasRecord.TargetType,
-		"dns_view":        *aliasRecord.View,
-		"dns_name":        aliasRecord.DnsName,
-		"dns_target_name": aliasRecord.DnsTargetName,
-		"creator":         aliasRecord.Creator,
-		"zone":            aliasRecord.Zone,
+		"id":                    aliasRecord.Ref,
+		"name":                  aliasRecord.Name,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
+		"target_name":           aliasRecord.TargetName,
+		"target_type":           aliasRecord.TargetType,
+		"dns_view":              *aliasRecord.View,
+		"dns_name":              aliasRecord.DnsName,
+		"dns_target_name":       aliasRecord.DnsTargetName,
+		"creator":               aliasRecord.Creator,
+		"zone":                  aliasRecord.Zone,
 	}
 	if aliasRecord.Comment != nil {
 		res["comment"] = *aliasRecord.Comment
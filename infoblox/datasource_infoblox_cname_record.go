@@ -5,15 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func dataSourceCNameRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceCNameRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -67,11 +65,12 @@ func dataSourceCNameRecord() *schema.Resource {
 							Computed:    true,
 							Description: "The Extensible attributes of CNAME record, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "CNAME records")))
 }
 
 func dataSourceCNameRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -83,18 +82,9 @@ func dataSourceCNameRecordRead(ctx context.Context, d *schema.ResourceData, m in
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "zone", "comment", "ttl"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.RecordCNAME
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.RecordCNAME](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.RecordCNAME{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting CNAME Record failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting CNAME Record failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -109,14 +99,10 @@ func dataSourceCNameRecordRead(ctx context.Context, d *schema.ResourceData, m in
 		results = append(results, recordcnameFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -134,9 +120,10 @@ func flattenRecordCNAME(recordcname ibclient.RecordCNAME) (map[string]interface{
 	}
 
 	res := map[string]interface{}{
-		"id":        recordcname.Ref,
-		"zone":      recordcname.Zone,
-		"ext_attrs": string(ea),
+		"id":                    recordcname.Ref,
+		"zone":                  recordcname.Zone,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if recordcname.UseTtl != nil {
@@ -0,0 +1,234 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"strings"
+	"time"
+)
+
+var (
+	leaseReturnFields = []string{
+		"address", "network", "network_view", "binding_state", "hardware", "ipv6_duid", "client_hostname",
+		"starts", "ends", "never_ends", "served_by", "server_host_name", "fingerprint", "protocol"}
+
+	// leaseSearchFields maps the arguments of the data source to the searchable fields of the lease object.
+	leaseSearchFields = map[string]string{
+		"network":         "network",
+		"network_view":    "network_view",
+		"address":         "address",
+		"mac":             "hardware",
+		"duid":            "ipv6_duid",
+		"client_hostname": "client_hostname",
+	}
+
+	leaseBindingStates = []string{
+		"ABANDONED", "ACTIVE", "BACKUP", "DECLINED", "EXPIRED", "FREE", "OFFERED", "RELEASED", "RESET", "STATIC"}
+)
+
+func dataSourceDHCPLeases() *schema.Resource {
+	return withSingleObjectMode(withPaging(&schema.Resource{
+		ReadContext: dataSourceDHCPLeasesRead,
+		Schema: map[string]*schema.Schema{
+			"network": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The network in CIDR format the leases belong to.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The network view the leases belong to.",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The leased IPv4 or IPv6 address.",
+			},
+			"mac": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The MAC address of the client of an IPv4 lease.",
+			},
+			"duid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The DUID of the client of an IPv6 lease.",
+			},
+			"binding_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(leaseBindingStates, false),
+				Description:  "The binding state of the leases, for example ACTIVE or FREE.",
+			},
+			"client_hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The host name sent by the client.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of DHCP leases matching the arguments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The leased IPv4 or IPv6 address.",
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network in CIDR format the lease belongs to.",
+						},
+						"network_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network view the lease belongs to.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The protocol of the lease: IPV4 or IPV6.",
+						},
+						"binding_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The binding state of the lease.",
+						},
+						"mac": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The MAC address of the client of an IPv4 lease.",
+						},
+						"duid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DUID of the client of an IPv6 lease.",
+						},
+						"client_hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The host name sent by the client.",
+						},
+						"starts": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start time of the lease in RFC 3339 format.",
+						},
+						"ends": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end time of the lease in RFC 3339 format; empty if the lease never ends.",
+						},
+						"served_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the server which issued the lease.",
+						},
+						"server_host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The host name of the grid member or Microsoft DHCP server which issued the lease.",
+						},
+						"fingerprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DHCP fingerprint of the client.",
+						},
+					},
+				},
+			},
+		},
+	}, "leases"))
+}
+
+func dataSourceDHCPLeasesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	filters := make(map[string]string)
+	for arg, field := range leaseSearchFields {
+		if v := d.Get(arg).(string); v != "" {
+			filters[field] = v
+		}
+	}
+	// The binding state is not a searchable field of the lease object, so the leases are filtered here.
+	bindingState := d.Get("binding_state").(string)
+	maxResults := d.Get("max_results").(int)
+
+	obj := &ibclient.Lease{}
+	obj.SetReturnFields(leaseReturnFields)
+	pager := newWapiPager(connector, obj, filters, d.Get("page_size").(int))
+
+	results := make([]interface{}, 0)
+	truncated := false
+	for pager.hasNext() && !truncated {
+		var page []ibclient.Lease
+		if err := pager.next(&page); err != nil {
+			return diag.FromErr(fmt.Errorf("getting DHCP leases failed: %w", err))
+		}
+		for _, l := range page {
+			if bindingState != "" && !strings.EqualFold(l.BindingState, bindingState) {
+				continue
+			}
+			if maxResults > 0 && len(results) == maxResults {
+				truncated = true
+				break
+			}
+			results = append(results, flattenLease(l))
+		}
+	}
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diag.FromErr(err)
+	}
+	arguments := []string{"binding_state"}
+	for arg := range leaseSearchFields {
+		arguments = append(arguments, arg)
+	}
+	if err := setDataSourceResults(d, results, arguments...); err != nil {
+		return diag.FromErr(fmt.Errorf("getting DHCP leases failed: %w", err))
+	}
+
+	return nil
+}
+
+func flattenLease(l ibclient.Lease) map[string]interface{} {
+	formatTime := func(t *ibclient.UnixTime) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	res := map[string]interface{}{
+		"id":               l.Ref,
+		"address":          l.Address,
+		"network":          l.Network,
+		"network_view":     l.NetworkView,
+		"protocol":         l.Protocol,
+		"binding_state":    l.BindingState,
+		"mac":              l.Hardware,
+		"duid":             l.Ipv6Duid,
+		"client_hostname":  l.ClientHostname,
+		"starts":           formatTime(l.Starts),
+		"ends":             formatTime(l.Ends),
+		"served_by":        l.ServedBy,
+		"server_host_name": l.ServerHostName,
+		"fingerprint":      l.Fingerprint,
+	}
+	if l.NeverEnds {
+		res["ends"] = ""
+	}
+
+	return res
+}
@@ -0,0 +1,45 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDataSourceDHCPLeases(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "infoblox_dhcp_leases" "all" {
+						page_size = 10
+						max_results = 5
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.infoblox_dhcp_leases.all", "results.#"),
+					resource.TestCheckResourceAttrSet("data.infoblox_dhcp_leases.all", "truncated"),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_dhcp_leases" "none" {
+						network = "10.199.199.0/24"
+						binding_state = "ACTIVE"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_dhcp_leases.none", "results.#", "0"),
+					resource.TestCheckResourceAttr("data.infoblox_dhcp_leases.none", "truncated", "false"),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_dhcp_leases" "invalid" {
+						binding_state = "UNKNOWN"
+					}`,
+				ExpectError: regexp.MustCompile("expected binding_state to be one of"),
+			},
+		},
+	})
+}
@@ -7,12 +7,44 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceDNSView() *schema.Resource {
-	return &schema.Resource{
+	resultSchema := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of th DNS View.",
+		},
+		"network_view": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of Network View in which DNS View exists.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of the DNS View.",
+		},
+		"ext_attrs": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The Extensible attributes of the DNS view to be added/updated, as a map in JSON format",
+		},
+		"extensible_attributes": dataSourceExtensibleAttributesSchema(),
+	}
+
+	// The configuration of DNS views is described the same way as in the resource.
+	viewSchema := resourceDNSView().Schema
+	for _, field := range dnsViewConfigReturnFields() {
+		resultSchema[field] = computedSchema(viewSchema[field])
+	}
+
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceDNSViewRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -24,37 +56,10 @@ func dataSourceDNSView() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "List of DNS View matching filters.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"name": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The name of th DNS View.",
-						},
-						"network_view": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The name of Network View in which DNS View exists.",
-						},
-						"comment": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Description of the DNS View.",
-						},
-						"ext_attrs": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The Extensible attributes of the DNS view to be added/updated, as a map in JSON format",
-						},
-					},
-				},
+				Elem:        &schema.Resource{Schema: resultSchema},
 			},
 		},
-	}
+	}, "DNS views")))
 }
 
 func dataSourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -63,21 +68,12 @@ func dataSourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interf
 	var diags diag.Diagnostics
 
 	dv := &ibclient.View{}
-	dv.SetReturnFields(append(dv.ReturnFields(), "extattrs", "network_view"))
+	dv.SetReturnFields(append(append(dv.ReturnFields(), "extattrs", "network_view"), dnsViewConfigReturnFields()...))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.View
-
-	err := connector.GetObject(dv, "", qp, &res)
+	res, err := getPagedObjects[ibclient.View](d, connector, dv, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.View{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting DNS View failed : %s", err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting DNS View failed : %s", err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -92,14 +88,10 @@ func dataSourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interf
 		results = append(results, dnsviewFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -117,8 +109,9 @@ func flattenDNSView(dnsview ibclient.View) (map[string]interface{}, error) {
 	}
 
 	res := map[string]interface{}{
-		"id":        dnsview.Ref,
-		"ext_attrs": string(ea),
+		"id":                    dnsview.Ref,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if dnsview.Name != nil {
@@ -133,5 +126,9 @@ func flattenDNSView(dnsview ibclient.View) (map[string]interface{}, error) {
 		res["comment"] = *dnsview.Comment
 	}
 
+	for field, value := range flattenDNSViewConfig(dnsview) {
+		res[field] = value
+	}
+
 	return res, nil
 }
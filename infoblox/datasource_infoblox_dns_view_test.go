@@ -17,6 +17,9 @@ func TestAccDataSourceDNSView(t *testing.T) {
 					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "results.0.name", "non_defaultview"),
 					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "results.0.network_view", "default"),
 					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "results.0.comment", "test dns view example"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "results.0.recursion", "true"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "results.0.use_recursion", "true"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_view.acctest", "results.0.match_clients.0.address", "10.0.0.0/8"),
 				),
 			},
 		},
@@ -28,6 +31,11 @@ resource "infoblox_dns_view" "foo"{
 	name = "non_defaultview"
 	network_view = "default" 
 	comment = "test dns view example"
+	recursion = true
+	use_recursion = true
+	match_clients {
+		address = "10.0.0.0/8"
+	}
 }
 
 data "infoblox_dns_view" "acctest" {
@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceDtcLbdnRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceDtcLbdnRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -68,6 +66,7 @@ func dataSourceDtcLbdnRecord() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the DTC LBDN record to be added/updated, as a map in JSON format.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"disable": {
 							Type:        schema.TypeBool,
 							Computed:    true,
@@ -146,7 +145,7 @@ func dataSourceDtcLbdnRecord() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "DTC LBDNs")))
 }
 
 func dataSourceDtcLbdnRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -156,10 +155,10 @@ func dataSourceDtcLbdnRecordRead(ctx context.Context, d *schema.ResourceData, m
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, _ := objMgr.GetAllDtcLbdn(qp)
+	res, err := getPagedObjects[ibclient.DtcLbdn](d, connector, ibclient.NewEmptyDtcLbdn(), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting DTC LBDN failed with filters %v: %s", filters, err.Error()))
+	}
 	results := make([]interface{}, 0, len(res))
 	for _, r := range res {
 		dtcLbdn, err := flattenDtcLbdn(r, connector)
@@ -169,14 +168,10 @@ func dataSourceDtcLbdnRecordRead(ctx context.Context, d *schema.ResourceData, m
 		results = append(results, dtcLbdn)
 	}
 
-	err := d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -194,10 +189,11 @@ func flattenDtcLbdn(lbdn ibclient.DtcLbdn, connector ibclient.IBConnector) (map[
 	}
 
 	res := map[string]interface{}{
-		"id":        lbdn.Ref,
-		"name":      lbdn.Name,
-		"ext_attrs": string(ea),
-		"lb_method": lbdn.LbMethod,
+		"id":                    lbdn.Ref,
+		"name":                  lbdn.Name,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
+		"lb_method":             lbdn.LbMethod,
 	}
 	if lbdn.Comment != nil {
 		res["comment"] = *lbdn.Comment
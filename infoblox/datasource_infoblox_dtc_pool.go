@@ -7,15 +7,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
 	"strings"
-
 	//"strings"
-	"time"
 )
 
 func datasourceDtcPool() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceDtcPoolRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -98,6 +95,7 @@ func datasourceDtcPool() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the  Dtc Pool to be added/updated, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"lb_preferred_method": {
 							Type:        schema.TypeString,
 							Required:    true,
@@ -188,7 +186,7 @@ func datasourceDtcPool() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "DTC pools")))
 }
 
 func dataSourceDtcPoolRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -198,10 +196,10 @@ func dataSourceDtcPoolRecordRead(ctx context.Context, d *schema.ResourceData, m
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, err := objMgr.GetAllDtcPool(qp)
+	res, err := getPagedObjects[ibclient.DtcPool](d, connector, ibclient.NewEmptyDtcPool(), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting DTC Pool failed with filters %v: %s", filters, err.Error()))
+	}
 	results := make([]interface{}, 0, len(res))
 	for _, r := range res {
 		dtcPool, err := flattenDtcPool(r, connector)
@@ -211,14 +209,10 @@ func dataSourceDtcPoolRecordRead(ctx context.Context, d *schema.ResourceData, m
 		results = append(results, dtcPool)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -236,10 +230,11 @@ func flattenDtcPool(pool ibclient.DtcPool, connector ibclient.IBConnector) (map[
 	}
 
 	res := map[string]interface{}{
-		"id":                  pool.Ref,
-		"name":                pool.Name,
-		"ext_attrs":           string(ea),
-		"lb_preferred_method": pool.LbPreferredMethod,
+		"id":                    pool.Ref,
+		"name":                  pool.Name,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
+		"lb_preferred_method":   pool.LbPreferredMethod,
 	}
 	if pool.AutoConsolidatedMonitors != nil {
 		res["auto_consolidated_monitors"] = *pool.AutoConsolidatedMonitors
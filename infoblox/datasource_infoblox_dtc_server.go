@@ -7,8 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func ConvertDtcHealthToMap(dtcHealth *ibclient.DtcHealth) map[string]string {
@@ -24,7 +22,7 @@ func ConvertDtcHealthToMap(dtcHealth *ibclient.DtcHealth) map[string]string {
 }
 
 func dataSourceDtcServer() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceDtcServerRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -61,6 +59,7 @@ func dataSourceDtcServer() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the  Dtc Server to be added/updated, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"host": {
 							Type:        schema.TypeString,
 							Required:    true,
@@ -118,7 +117,7 @@ func dataSourceDtcServer() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "DTC servers")))
 }
 func dataSourceDtcServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := m.(ibclient.IBConnector)
@@ -127,11 +126,10 @@ func dataSourceDtcServerRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-
-	res, err := objMgr.GetAllDtcServer(qp)
+	res, err := getPagedObjects[ibclient.DtcServer](d, connector, ibclient.NewEmptyDtcServer(), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting DTC Server failed with filters %v: %s", filters, err.Error()))
+	}
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
 	//       (avoiding additional layer of keys ("value" key)
 	results := make([]interface{}, 0, len(res))
@@ -142,13 +140,9 @@ func dataSourceDtcServerRead(ctx context.Context, d *schema.ResourceData, m inte
 		}
 		results = append(results, dsFlat)
 	}
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
-
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
 	return diags
 }
 func flattenDtcServer(dtcServer ibclient.DtcServer, connector ibclient.IBConnector) (map[string]interface{}, error) {
@@ -165,10 +159,11 @@ func flattenDtcServer(dtcServer ibclient.DtcServer, connector ibclient.IBConnect
 	}
 
 	res := map[string]interface{}{
-		"id":        dtcServer.Ref,
-		"ext_attrs": string(ea),
-		"name":      *dtcServer.Name,
-		"host":      *dtcServer.Host,
+		"id":                    dtcServer.Ref,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
+		"name":                  *dtcServer.Name,
+		"host":                  *dtcServer.Host,
 	}
 	if dtcServer.AutoCreateHostRecord != nil {
 		res["auto_create_host_record"] = *dtcServer.AutoCreateHostRecord
@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceFixedAddress() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceFixedAddressRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -65,6 +63,7 @@ func dataSourceFixedAddress() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the A-record to be added/updated, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"ipv4addr": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -143,7 +142,7 @@ func dataSourceFixedAddress() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "fixed addresses")))
 }
 
 func dataSourceFixedAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -152,10 +151,10 @@ func dataSourceFixedAddressRead(ctx context.Context, d *schema.ResourceData, m i
 	var diags diag.Diagnostics
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, err := objMgr.GetAllFixedAddress(qp, false)
+	res, err := getPagedObjects[ibclient.FixedAddress](d, connector, ibclient.NewEmptyFixedAddress(false), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting Fixed Address failed with filters %v: %s", filters, err.Error()))
+	}
 	results := make([]interface{}, 0, len(res))
 	for _, r := range res {
 		fixedAddress, err := flattenFixedAddress(r)
@@ -165,14 +164,10 @@ func dataSourceFixedAddressRead(ctx context.Context, d *schema.ResourceData, m i
 		results = append(results, fixedAddress)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -203,6 +198,7 @@ func flattenFixedAddress(fixedAddress ibclient.FixedAddress) (map[string]interfa
 		"disable":                        fixedAddress.Disable,
 		"dhcp_client_identifier":         fixedAddress.DhcpClientIdentifier,
 		"ext_attrs":                      string(ea),
+		"extensible_attributes":          flattenExtensibleAttributes(eaMap, nil),
 		"name":                           fixedAddress.Name,
 	}
 	if fixedAddress.Options != nil {
@@ -0,0 +1,116 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceGridCapacityReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGridCapacityReportRead,
+		Schema: map[string]*schema.Schema{
+			"member": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The host name of the grid member to return the capacity report of; all members by default.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The capacity reports of the grid members.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The host name of the grid member.",
+						},
+						"hardware_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hardware type of the grid member.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role of the grid member in the grid.",
+						},
+						"max_capacity": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum number of objects the grid member can hold.",
+						},
+						"total_objects": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of objects held by the grid member.",
+						},
+						"percent_used": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The used capacity of the grid member in percent.",
+						},
+						"object_counts": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The number of objects held by the grid member, per object type.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGridCapacityReportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var reports []ibclient.CapacityReport
+	var err error
+	if name := d.Get("member").(string); name != "" {
+		objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+		reports, err = objMgr.GetCapacityReport(name)
+	} else {
+		err = connector.GetObject(
+			ibclient.NewCapcityReport(ibclient.CapacityReport{}), "", ibclient.NewQueryParams(false, nil), &reports)
+	}
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(fmt.Errorf("getting the capacity report failed: %w", err))
+	}
+
+	results := make([]interface{}, 0, len(reports))
+	refs := make([]string, 0, len(reports))
+	for _, r := range reports {
+		results = append(results, flattenCapacityReport(r))
+		refs = append(refs, r.Ref)
+	}
+	if err = d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(dataSourceResultsId(refs))
+
+	return nil
+}
+
+func flattenCapacityReport(r ibclient.CapacityReport) map[string]interface{} {
+	counts := make(map[string]interface{}, len(r.ObjectCounts))
+	for _, c := range r.ObjectCounts {
+		if c != nil {
+			counts[c.TypeName] = int(c.Count)
+		}
+	}
+
+	return map[string]interface{}{
+		"name":          r.Name,
+		"hardware_type": r.HardwareType,
+		"role":          r.Role,
+		"max_capacity":  int(r.MaxCapacity),
+		"total_objects": int(r.TotalObjects),
+		"percent_used":  int(r.PercentUsed),
+		"object_counts": counts,
+	}
+}
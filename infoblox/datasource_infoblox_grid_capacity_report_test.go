@@ -0,0 +1,31 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceGridCapacityReport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "infoblox_grid_capacity_report" "all" {
+					}
+
+					data "infoblox_grid_capacity_report" "member" {
+						member = data.infoblox_grid_capacity_report.all.results[0].name
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.infoblox_grid_capacity_report.all", "results.0.name"),
+					resource.TestCheckResourceAttr("data.infoblox_grid_capacity_report.member", "results.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.infoblox_grid_capacity_report.member", "results.0.max_capacity",
+						"data.infoblox_grid_capacity_report.all", "results.0.max_capacity"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,190 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"strings"
+)
+
+// gridServiceRestartStatusCounters are the fields of the grid:servicerestart:status object
+// exposed by the data source.
+var gridServiceRestartStatusCounters = map[string]string{
+	"needed_restart":  "The number of created yet unprocessed restart requests.",
+	"pending_restart": "The number of forced or needed restart requests pending for a restart.",
+	"pending":         "The number of restart requests pending for a restart.",
+	"processing":      "The number of not forced and not needed restart requests pending for a restart.",
+	"restarting":      "The number of services which are being restarted.",
+	"success":         "The number of successful restarts.",
+	"failures":        "The number of failed restarts.",
+	"timeouts":        "The number of timed out restarts.",
+	"finished":        "The number of finished restart requests.",
+	"no_restart":      "The number of restart requests which did not require a restart.",
+}
+
+func dataSourceGridServiceRestartStatus() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"restart_group": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the restart group to show the status of; the whole grid by default.",
+		},
+		"refresh": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+			Description: "If true, the grid members are requested to refresh the status of their services " +
+				"before it is read.",
+		},
+		"restart_pending": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether there are changes which require a restart of the services.",
+		},
+		"members": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The status of the services on the grid members.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"member": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The host name of the grid member.",
+					},
+					"dns_status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the DNS service.",
+					},
+					"dhcp_status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the DHCP service.",
+					},
+					"reporting_status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the reporting service.",
+					},
+				},
+			},
+		},
+		"requests": gridServiceRestartRequestsSchema(),
+	}
+	for field, description := range gridServiceRestartStatusCounters {
+		s[field] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: description,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceGridServiceRestartStatusRead,
+		Schema:      s,
+	}
+}
+
+// getGridServiceRestartStatus returns the restart status of the restart group with the given reference,
+// or of the whole grid if the reference is empty.
+func getGridServiceRestartStatus(conn ibclient.IBConnector, groupRef string) (*ibclient.GridServicerestartStatus, error) {
+	status := &ibclient.GridServicerestartStatus{}
+	var res []ibclient.GridServicerestartStatus
+	if err := conn.GetObject(status, "", ibclient.NewQueryParams(false, nil), &res); err != nil && !isNotFoundError(err) {
+		return nil, err
+	}
+	for _, s := range res {
+		if groupRef != "" && s.Parent == groupRef || groupRef == "" && strings.HasPrefix(s.Parent, "grid/") {
+			return &s, nil
+		}
+	}
+
+	return &ibclient.GridServicerestartStatus{}, nil
+}
+
+func dataSourceGridServiceRestartStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if d.Get("refresh").(bool) {
+		gridRef, err := getGridRef(conn)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read the grid object: %w", err))
+		}
+		err = callWapiFunction(conn, gridRef, "requestrestartservicestatus",
+			map[string]interface{}{"service_option": restartServiceAll}, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to refresh the status of the services: %w", err))
+		}
+	}
+
+	var groupRef string
+	var groups []string
+	if name := d.Get("restart_group").(string); name != "" {
+		group, err := getGridServiceRestartGroup(conn, name)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read restart group '%s': %w", name, err))
+		}
+		groupRef = group.Ref
+		groups = []string{name}
+	}
+
+	status, err := getGridServiceRestartStatus(conn, groupRef)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read the restart status: %w", err))
+	}
+	counters := map[string]uint32{
+		"needed_restart":  status.NeededRestart,
+		"pending_restart": status.PendingRestart,
+		"pending":         status.Pending,
+		"processing":      status.Processing,
+		"restarting":      status.Restarting,
+		"success":         status.Success,
+		"failures":        status.Failures,
+		"timeouts":        status.Timeouts,
+		"finished":        status.Finished,
+		"no_restart":      status.NoRestart,
+	}
+	for field, value := range counters {
+		if err = d.Set(field, int(value)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var memberStatuses []ibclient.Restartservicestatus
+	err = conn.GetObject(&ibclient.Restartservicestatus{}, "", ibclient.NewQueryParams(false, nil), &memberStatuses)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(fmt.Errorf("failed to read the status of the services: %w", err))
+	}
+	members := make([]interface{}, 0, len(memberStatuses))
+	for _, s := range memberStatuses {
+		members = append(members, map[string]interface{}{
+			"member":           s.Member,
+			"dns_status":       s.DnsStatus,
+			"dhcp_status":      s.DhcpStatus,
+			"reporting_status": s.ReportingStatus,
+		})
+	}
+	if err = d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	requests, err := getGridServiceRestartRequests(conn, groups, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read restart requests: %w", err))
+	}
+	if err = d.Set("requests", flattenGridServiceRestartRequests(requests)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("restart_pending", status.NeededRestart > 0 || status.PendingRestart > 0); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The status object of the restart group or of the grid identifies the data source.
+	d.SetId(dataSourceResultsId([]string{status.Ref}))
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceGridServiceRestartStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "infoblox_grid_service_restart_status" "status" {
+						refresh = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.infoblox_grid_service_restart_status.status", "restart_pending"),
+					resource.TestCheckResourceAttrSet("data.infoblox_grid_service_restart_status.status", "pending_restart"),
+					resource.TestCheckResourceAttrSet("data.infoblox_grid_service_restart_status.status", "members.#"),
+					resource.TestCheckResourceAttrSet("data.infoblox_grid_service_restart_status.status", "requests.#"),
+				),
+			},
+		},
+	})
+}
@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceHostRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceHostRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -73,6 +71,7 @@ func dataSourceHostRecord() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the Host-record, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"mac_addr": {
 							Type:        schema.TypeString,
 							Optional:    true,
@@ -113,7 +112,7 @@ func dataSourceHostRecord() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "host records")))
 }
 
 func dataSourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -125,18 +124,9 @@ func dataSourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m int
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "comment", "zone", "ttl", "configure_for_dns", "aliases", "disable"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.HostRecord
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.HostRecord](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.HostRecord{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting Host Record failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting Host Record failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -151,14 +141,10 @@ func dataSourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m int
 		results = append(results, recordaFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -176,10 +162,11 @@ func flattenRecordHost(hostRecord ibclient.HostRecord) (map[string]interface{},
 	}
 
 	res := map[string]interface{}{
-		"id":        hostRecord.Ref,
-		"zone":      hostRecord.Zone,
-		"dns_view":  hostRecord.View,
-		"ext_attrs": string(ea),
+		"id":                    hostRecord.Ref,
+		"zone":                  hostRecord.Zone,
+		"dns_view":              hostRecord.View,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if hostRecord.Ipv4Addrs != nil {
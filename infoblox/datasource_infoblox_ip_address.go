@@ -0,0 +1,301 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var (
+	ipAddressStatuses = []string{"USED", "UNUSED"}
+
+	ipAddressCommonReturnFields = []string{
+		"ip_address", "network", "network_view", "status", "types", "usage", "objects", "names",
+		"lease_state", "is_conflict", "conflict_types", "comment"}
+	ipv4AddressReturnFields = append([]string{"mac_address"}, ipAddressCommonReturnFields...)
+	ipv6AddressReturnFields = append([]string{"duid"}, ipAddressCommonReturnFields...)
+)
+
+func dataSourceIPv4Address() *schema.Resource {
+	return dataSourceIPAddress(false)
+}
+
+func dataSourceIPv6Address() *schema.Resource {
+	return dataSourceIPAddress(true)
+}
+
+// dataSourceIPAddress returns the data source of the status of IPv4 or IPv6 addresses,
+// represented by the 'ipv4address' and 'ipv6address' WAPI objects.
+func dataSourceIPAddress(isIPv6 bool) *schema.Resource {
+	clientIdField, clientIdDescription := "mac_address", "The MAC address of the client the address is assigned to."
+	if isIPv6 {
+		clientIdField, clientIdDescription = "duid", "The DUID of the client the address is assigned to."
+	}
+
+	return withSingleObjectMode(withPaging(&schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return dataSourceIPAddressRead(ctx, d, m, isIPv6)
+		},
+		Schema: map[string]*schema.Schema{
+			"network": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The network in CIDR format to return the addresses of.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The network view the addresses belong to.",
+			},
+			"ip_address": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"start_address", "end_address"},
+				Description:   "The IP address to return the status of.",
+			},
+			"start_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The first address of the range of addresses to return.",
+			},
+			"end_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The last address of the range of addresses to return.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ipAddressStatuses, false),
+				Description:  "The status of the addresses: USED or UNUSED.",
+			},
+			"types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Return only the addresses, which are referenced by objects of any of the given types, for example HOST, A or FA.",
+			},
+			"usage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Return only the addresses with any of the given usages: DHCP or DNS.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of IP addresses matching the arguments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network in CIDR format the address belongs to.",
+						},
+						"network_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network view the address belongs to.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the address: USED or UNUSED.",
+						},
+						"types": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The types of the objects referencing the address.",
+						},
+						"usage": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The usage of the address: DHCP and/or DNS.",
+						},
+						"objects": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The references of the objects associated with the address.",
+						},
+						"names": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names associated with the address.",
+						},
+						clientIdField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: clientIdDescription,
+						},
+						"lease_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the DHCP lease of the address.",
+						},
+						"is_conflict": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the address is in conflict.",
+						},
+						"conflict_types": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The types of the conflicts of the address.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The comment of the address.",
+						},
+					},
+				},
+			},
+		},
+	}, "addresses"))
+}
+
+func dataSourceIPAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}, isIPv6 bool) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	filters := make(map[string]string)
+	for _, field := range []string{"network", "network_view", "ip_address", "status"} {
+		if v := d.Get(field).(string); v != "" {
+			filters[field] = v
+		}
+	}
+	// NIOS interprets the '>' and '<' modifiers of a search field as 'greater or equal' and 'less or equal'.
+	if v := d.Get("start_address").(string); v != "" {
+		filters["ip_address>"] = v
+	}
+	if v := d.Get("end_address").(string); v != "" {
+		filters["ip_address<"] = v
+	}
+	// The network view and the status only narrow the search of the addresses of a network or a range.
+	if filters["network"] == "" && filters["ip_address"] == "" && filters["ip_address>"] == "" && filters["ip_address<"] == "" {
+		return diag.FromErr(fmt.Errorf(
+			"at least one of 'network', 'ip_address', 'start_address' and 'end_address' must be specified"))
+	}
+	// The types and usage are lists, which are matched by any of their values here.
+	types := expandStringList(d.Get("types").([]interface{}))
+	usage := expandStringList(d.Get("usage").([]interface{}))
+	maxResults := d.Get("max_results").(int)
+
+	var obj ibclient.IBObject
+	if isIPv6 {
+		obj = &ibclient.IPv6Address{}
+		obj.SetReturnFields(ipv6AddressReturnFields)
+	} else {
+		obj = &ibclient.IPv4Address{}
+		obj.SetReturnFields(ipv4AddressReturnFields)
+	}
+	pager := newWapiPager(connector, obj, filters, d.Get("page_size").(int))
+
+	results := make([]interface{}, 0)
+	truncated := false
+	for pager.hasNext() && !truncated {
+		page, err := nextIPAddressPage(pager, isIPv6)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("getting IP addresses failed: %w", err))
+		}
+		for _, a := range page {
+			if len(types) > 0 && !containsAny(a["types"].([]string), types) ||
+				len(usage) > 0 && !containsAny(a["usage"].([]string), usage) {
+				continue
+			}
+			if maxResults > 0 && len(results) == maxResults {
+				truncated = true
+				break
+			}
+			results = append(results, a)
+		}
+	}
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diag.FromErr(err)
+	}
+	err := setDataSourceResults(d, results, "network", "network_view", "ip_address", "status", "types", "usage")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting IP addresses failed: %w", err))
+	}
+
+	return nil
+}
+
+// nextIPAddressPage fetches the next page of the 'ipv4address' or 'ipv6address' objects.
+func nextIPAddressPage(pager *wapiPager, isIPv6 bool) ([]map[string]interface{}, error) {
+	var res []map[string]interface{}
+	if isIPv6 {
+		var page []ibclient.IPv6Address
+		if err := pager.next(&page); err != nil {
+			return nil, err
+		}
+		for _, a := range page {
+			res = append(res, flattenIPv6AddressStatus(a))
+		}
+	} else {
+		var page []ibclient.IPv4Address
+		if err := pager.next(&page); err != nil {
+			return nil, err
+		}
+		for _, a := range page {
+			res = append(res, flattenIPv4AddressStatus(a))
+		}
+	}
+
+	return res, nil
+}
+
+func flattenIPv4AddressStatus(a ibclient.IPv4Address) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             a.Ref,
+		"ip_address":     a.IpAddress,
+		"network":        a.Network,
+		"network_view":   a.NetworkView,
+		"status":         a.Status,
+		"types":          a.Types,
+		"usage":          a.Usage,
+		"objects":        a.Objects,
+		"names":          a.Names,
+		"mac_address":    a.MacAddress,
+		"lease_state":    a.LeaseState,
+		"is_conflict":    a.IsConflict,
+		"conflict_types": a.ConflictTypes,
+		"comment":        a.Comment,
+	}
+}
+
+func flattenIPv6AddressStatus(a ibclient.IPv6Address) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             a.Ref,
+		"ip_address":     a.IpAddress,
+		"network":        a.Network,
+		"network_view":   a.NetworkView,
+		"status":         a.Status,
+		"types":          a.Types,
+		"usage":          a.Usage,
+		"objects":        a.Objects,
+		"names":          a.Names,
+		"duid":           a.Duid,
+		"lease_state":    a.LeaseState,
+		"is_conflict":    a.IsConflict,
+		"conflict_types": a.ConflictTypes,
+		"comment":        a.Comment,
+	}
+}
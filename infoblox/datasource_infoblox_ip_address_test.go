@@ -0,0 +1,106 @@
+package infoblox
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestDataSourceIPAddressReadRequiresScope(t *testing.T) {
+	conn := &testSchemaConnector{}
+	d := schema.TestResourceDataRaw(t, dataSourceIPv4Address().Schema, map[string]interface{}{
+		"network_view": "default",
+		"status":       "USED",
+	})
+	diags := dataSourceIPAddressRead(context.Background(), d, conn, false)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "at least one of 'network', 'ip_address'") {
+		t.Errorf("expected the network view and the status alone to be rejected, got %v", diags)
+	}
+	if conn.requests != 0 {
+		t.Errorf("expected no search without a network or an address, got %d requests", conn.requests)
+	}
+}
+
+func TestAccDataSourceIPv4Address(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.33.0.0/28"
+					}
+
+					resource "infoblox_ipv4_fixed_address" "fa" {
+						ipv4addr = "10.33.0.5"
+						mac = "00:11:22:33:44:55"
+						depends_on = [infoblox_ipv4_network.net]
+					}
+
+					data "infoblox_ipv4_address" "all" {
+						network = "10.33.0.0/28"
+						depends_on = [infoblox_ipv4_fixed_address.fa]
+					}
+
+					data "infoblox_ipv4_address" "used" {
+						network = "10.33.0.0/28"
+						status = "USED"
+						types = ["FA"]
+						depends_on = [infoblox_ipv4_fixed_address.fa]
+					}
+
+					data "infoblox_ipv4_address" "range" {
+						start_address = "10.33.0.2"
+						end_address = "10.33.0.6"
+						max_results = 3
+						depends_on = [infoblox_ipv4_fixed_address.fa]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.all", "results.#", "16"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "results.0.ip_address", "10.33.0.5"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "results.0.mac_address", "00:11:22:33:44:55"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "results.0.is_conflict", "false"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.range", "results.#", "3"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.range", "truncated", "true"),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_ipv4_address" "none" {
+						status = "USED"
+					}`,
+				ExpectError: regexp.MustCompile("at least one of 'network', 'ip_address'"),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceIPv6Address(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net" {
+						cidr = "2001:db8:33::/64"
+					}
+
+					data "infoblox_ipv6_address" "addr" {
+						ip_address = "2001:db8:33::10"
+						depends_on = [infoblox_ipv6_network.net]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_address.addr", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_address.addr", "results.0.status", "UNUSED"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv6_address.addr", "results.0.network", "2001:db8:33::/64"),
+				),
+			},
+		},
+	})
+}
@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceRange() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceRangeRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -69,6 +67,7 @@ func dataSourceRange() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the range to be added/updated, as a map in JSON format.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"failover_association": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -148,7 +147,7 @@ func dataSourceRange() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "ranges")))
 }
 func dataSourceRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := m.(ibclient.IBConnector)
@@ -156,9 +155,10 @@ func dataSourceRangeRead(ctx context.Context, d *schema.ResourceData, m interfac
 	var diags diag.Diagnostics
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-	qp := ibclient.NewQueryParams(false, filters)
-	res, err := objMgr.GetNetworkRange(qp)
+	res, err := getPagedObjects[ibclient.Range](d, connector, ibclient.NewEmptyRange(), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting Network Range failed with filters %v: %s", filters, err.Error()))
+	}
 	results := make([]interface{}, 0, len(res))
 	for _, r := range res {
 		dtcPool, err := flattenNetworkRange(r)
@@ -168,14 +168,10 @@ func dataSourceRangeRead(ctx context.Context, d *schema.ResourceData, m interfac
 		results = append(results, dtcPool)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -195,6 +191,7 @@ func flattenNetworkRange(networkRange ibclient.Range) (map[string]interface{}, e
 	res := map[string]interface{}{
 		"id":                      networkRange.Ref,
 		"ext_attrs":               string(ea),
+		"extensible_attributes":   flattenExtensibleAttributes(eaMap, nil),
 		"server_association_type": networkRange.ServerAssociationType,
 	}
 	if networkRange.Network != nil {
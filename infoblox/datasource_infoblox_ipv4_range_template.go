@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceRangeTemplate() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceRangeTemplateRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -132,11 +130,12 @@ func dataSourceRangeTemplate() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the  Range Template Record to be added/updated, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "range templates")))
 }
 
 func dataSourceRangeTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -146,10 +145,10 @@ func dataSourceRangeTemplateRead(ctx context.Context, d *schema.ResourceData, m
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, err := objMgr.GetAllRangeTemplate(qp)
+	res, err := getPagedObjects[ibclient.Rangetemplate](d, connector, ibclient.NewEmptyRangeTemplate(), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting Range Template failed with filters %v: %s", filters, err.Error()))
+	}
 	results := make([]interface{}, 0, len(res))
 	for _, r := range res {
 		rangeTemplate, err := flattenRangeTemplate(r, connector)
@@ -159,14 +158,10 @@ func dataSourceRangeTemplateRead(ctx context.Context, d *schema.ResourceData, m
 		results = append(results, rangeTemplate)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -187,6 +182,7 @@ func flattenRangeTemplate(rangeTemplate ibclient.Rangetemplate, connector ibclie
 		"id":                      rangeTemplate.Ref,
 		"name":                    rangeTemplate.Name,
 		"ext_attrs":               string(ea),
+		"extensible_attributes":   flattenExtensibleAttributes(eaMap, nil),
 		"number_of_addresses":     int(*rangeTemplate.NumberOfAddresses),
 		"offset":                  int(*rangeTemplate.Offset),
 		"server_association_type": rangeTemplate.ServerAssociationType,
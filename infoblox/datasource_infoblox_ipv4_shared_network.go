@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceIpv4SharedNetwork() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceIpv4SharedNetworkRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -41,6 +39,7 @@ func dataSourceIpv4SharedNetwork() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the IPv4 Shared Network record to be added/updated, as a map in JSON format.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"disable": {
 							Type:        schema.TypeBool,
 							Computed:    true,
@@ -111,17 +110,17 @@ func dataSourceIpv4SharedNetwork() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "shared networks")))
 }
 
 func dataSourceIpv4SharedNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := m.(ibclient.IBConnector)
 	var diags diag.Diagnostics
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, err := objMgr.GetAllIpv4SharedNetwork(qp)
+	res, err := getPagedObjects[ibclient.SharedNetwork](d, connector, ibclient.NewEmptyIpv4SharedNetwork(), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting Shared Network failed with filters %v: %s", filters, err.Error()))
+	}
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
 	//       (avoiding additional layer of keys ("value" key)
 	results := make([]interface{}, 0, len(res))
@@ -133,14 +132,10 @@ func dataSourceIpv4SharedNetworkRead(ctx context.Context, d *schema.ResourceData
 		results = append(results, record)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -158,10 +153,11 @@ func flattenSharedNetwork(sharedNetwork ibclient.SharedNetwork) (interface{}, er
 	}
 
 	res := map[string]interface{}{
-		"id":           sharedNetwork.Ref,
-		"name":         *sharedNetwork.Name,
-		"ext_attrs":    string(ea),
-		"network_view": sharedNetwork.NetworkView,
+		"id":                    sharedNetwork.Ref,
+		"name":                  *sharedNetwork.Name,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
+		"network_view":          sharedNetwork.NetworkView,
 	}
 	if sharedNetwork.Comment != nil {
 		res["comment"] = *sharedNetwork.Comment
@@ -0,0 +1,89 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceMsServer() *schema.Resource {
+	resultSchema := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ext_attrs": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Extensible attributes of the Microsoft server, as a map in JSON format",
+		},
+		"extensible_attributes": dataSourceExtensibleAttributesSchema(),
+	}
+
+	// The Microsoft servers are described the same way as in the resource, except for the password.
+	serverSchema := resourceMsServer().Schema
+	for field, s := range serverSchema {
+		switch field {
+		case "login_password", "login_password_version", "ext_attrs", "extensible_attributes", "all_ext_attrs", "ref":
+			continue
+		}
+		resultSchema[field] = computedSchema(s)
+	}
+
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
+		ReadContext: dataSourceMsServerRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of Microsoft servers matching filters.",
+				Elem:        &schema.Resource{Schema: resultSchema},
+			},
+		},
+	}, "Microsoft servers")))
+}
+
+func dataSourceMsServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	obj := &ibclient.Msserver{}
+	obj.SetReturnFields(msServerReturnFields)
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	res, err := getPagedObjects[ibclient.Msserver](d, connector, obj, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting Microsoft server failed: %w", err))
+	}
+
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		server := flattenMsServer(r)
+
+		eaMap := map[string]interface{}(r.Ea)
+		if eaMap == nil {
+			eaMap = make(map[string]interface{})
+		}
+		ea, err := json.Marshal(eaMap)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		server["ext_attrs"] = string(ea)
+		server["extensible_attributes"] = flattenExtensibleAttributes(eaMap, nil)
+
+		results = append(results, server)
+	}
+
+	if err = setDataSourceResults(d, results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
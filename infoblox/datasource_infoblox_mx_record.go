@@ -5,15 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func dataSourceMXRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceMXRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -72,11 +70,12 @@ func dataSourceMXRecord() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the TXT-record, as a map in JSON format.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "MX records")))
 }
 
 func dataSourceMXRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -88,18 +87,9 @@ func dataSourceMXRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "zone", "ttl", "comment"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.RecordMX
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.RecordMX](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.RecordMX{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting MX Record failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting MX Record failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -114,14 +104,10 @@ func dataSourceMXRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 		results = append(results, recordmxFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -139,9 +125,10 @@ func flattenRecordMX(recordmx ibclient.RecordMX) (map[string]interface{}, error)
 	}
 
 	res := map[string]interface{}{
-		"id":        recordmx.Ref,
-		"zone":      recordmx.Zone,
-		"ext_attrs": string(ea),
+		"id":                    recordmx.Ref,
+		"zone":                  recordmx.Zone,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if recordmx.Preference != nil {
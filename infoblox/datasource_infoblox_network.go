@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceNetwork() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		//ReadContext: dataSourceIPv4NetworkRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -49,6 +47,7 @@ func dataSourceNetwork() *schema.Resource {
 							Computed:    true,
 							Description: "The Extensible attributes for network datasource, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"utilization": {
 							Type:        schema.TypeInt,
 							Computed:    true,
@@ -116,7 +115,7 @@ func dataSourceNetwork() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "networks")))
 }
 
 func flattenVlans(vlans []*ibclient.Vlanlink) []interface{} {
@@ -147,18 +146,9 @@ func dataSourceIPv4NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "options", "utilization", "vlans"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.Ipv4Network
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.Ipv4Network](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.Ipv4Network{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting IPv4 network failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting IPv4 network failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -173,14 +163,10 @@ func dataSourceIPv4NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 		results = append(results, networkFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -197,10 +183,11 @@ func flattenIpv4Network(network ibclient.Ipv4Network) (map[string]interface{}, e
 	}
 
 	res := map[string]interface{}{
-		"id":           network.Ref,
-		"network_view": network.NetworkView,
-		"ext_attrs":    string(ea),
-		"utilization":  network.Utilization,
+		"id":                    network.Ref,
+		"network_view":          network.NetworkView,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
+		"utilization":           network.Utilization,
 	}
 
 	if network.Network != nil {
@@ -234,9 +221,10 @@ func flattenIpv6Network(network ibclient.Ipv6Network) (map[string]interface{}, e
 	}
 
 	res := map[string]interface{}{
-		"id":           network.Ref,
-		"network_view": network.NetworkView,
-		"ext_attrs":    string(ea),
+		"id":                    network.Ref,
+		"network_view":          network.NetworkView,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if network.Network != nil {
@@ -265,18 +253,9 @@ func dataSourceIPv6NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "options", "vlans"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.Ipv6Network
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.Ipv6Network](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.Ipv6Network{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting IPv6 network failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting IPv6 network failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -291,14 +270,10 @@ func dataSourceIPv6NetworkRead(ctx context.Context, d *schema.ResourceData, m in
 		results = append(results, networkFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
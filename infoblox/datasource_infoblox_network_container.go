@@ -5,15 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func dataSourceIpv4NetworkContainer() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceIpv4NetworkContainerRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -52,11 +50,12 @@ func dataSourceIpv4NetworkContainer() *schema.Resource {
 							Computed:    true,
 							Description: "The Extensible attributes for the network container.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "network containers")))
 }
 
 func dataSourceIpv4NetworkContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -66,18 +65,9 @@ func dataSourceIpv4NetworkContainerRead(ctx context.Context, d *schema.ResourceD
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.Ipv4NetworkContainer
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.Ipv4NetworkContainer](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.Ipv4NetworkContainer{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting IPv4 network container failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting IPv4 network container failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -92,14 +82,10 @@ func dataSourceIpv4NetworkContainerRead(ctx context.Context, d *schema.ResourceD
 		results = append(results, networkContainerFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return nil
 }
 
@@ -116,10 +102,11 @@ func flattenNetworkContainer(nc ibclient.Ipv4NetworkContainer) (map[string]inter
 	}
 
 	res := map[string]interface{}{
-		"id":           nc.Ref,
-		"network_view": nc.NetworkView,
-		"cidr":         nc.Network,
-		"ext_attrs":    string(ea),
+		"id":                    nc.Ref,
+		"network_view":          nc.NetworkView,
+		"cidr":                  nc.Network,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if nc.Comment != nil {
@@ -0,0 +1,194 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"time"
+)
+
+// dhcpUtilizationScale is the factor the DHCP utilization percentage is multiplied by in the 'dhcp:statistics' object.
+const dhcpUtilizationScale = 1000
+
+func dataSourceNetworkUtilization() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkUtilizationRead,
+		Schema: map[string]*schema.Schema{
+			"network": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"network", "range_start_addr"},
+				ValidateFunc: validation.IsCIDR,
+				Description:  "The IPv4 network or network container in CIDR format to return the utilization of.",
+			},
+			"range_start_addr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				Description:  "The start address of the IPv4 range to return the utilization of.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The network view of the network, network container or range.",
+			},
+			"object_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the object the utilization is returned for: network, networkcontainer or range.",
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The IPAM utilization of the network or network container in percent; zero for a range.",
+			},
+			"unmanaged_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of unmanaged addresses of the network, as discovered by network discovery.",
+			},
+			"conflict_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of conflicts of the network, as discovered by network discovery.",
+			},
+			"utilization_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The time of the last update of the IPAM utilization of the network in RFC 3339 format; " +
+					"empty if unknown.",
+			},
+			"dhcp_utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The DHCP utilization of the network or range in percent; zero for a network container.",
+			},
+			"dhcp_utilization_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The utilization level of the network or range, for example LOW or FULL.",
+			},
+			"total_hosts": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of DHCP addresses of the network or range.",
+			},
+			"used_hosts": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of used DHCP addresses of the network or range: the sum of static and dynamic hosts.",
+			},
+			"static_hosts": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of static DHCP addresses of the network or range.",
+			},
+			"dynamic_hosts": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of DHCP leases issued for the network or range.",
+			},
+		},
+	}
+}
+
+// getIpamStatistics returns the IPAM statistics of the IPv4 network or network container.
+func getIpamStatistics(conn ibclient.IBConnector, network, netView string) (*ibclient.IpamStatistics, error) {
+	obj := &ibclient.IpamStatistics{}
+	obj.SetReturnFields([]string{"network", "network_view", "utilization", "unmanaged_count", "conflict_count", "utilization_update"})
+	var res []ibclient.IpamStatistics
+	sf := map[string]string{"network": network, "network_view": netView}
+	if err := conn.GetObject(obj, "", ibclient.NewQueryParams(false, sf), &res); err != nil && !isNotFoundError(err) {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return &ibclient.IpamStatistics{}, nil
+	}
+
+	return &res[0], nil
+}
+
+// getDhcpStatistics returns the DHCP statistics of the network or range with the given reference.
+func getDhcpStatistics(conn ibclient.IBConnector, ref string) (*ibclient.DhcpStatistics, error) {
+	var res []ibclient.DhcpStatistics
+	sf := map[string]string{"statistics_object": ref}
+	err := conn.GetObject(&ibclient.DhcpStatistics{}, "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil && !isNotFoundError(err) {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return &ibclient.DhcpStatistics{}, nil
+	}
+
+	return &res[0], nil
+}
+
+func dataSourceNetworkUtilizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+	netView := d.Get("network_view").(string)
+	network := d.Get("network").(string)
+
+	var objType, scope, ref string
+	var err error
+	if network != "" {
+		scope = network
+		sf := map[string]string{"network": network, "network_view": netView}
+		// The network may be either a network or a network container.
+		for _, objType = range []string{"network", "networkcontainer"} {
+			if ref, err = getObjectRef(conn, objType, sf); err == nil || !isNotFoundError(err) {
+				break
+			}
+		}
+	} else {
+		objType, scope = "range", d.Get("range_start_addr").(string)
+		ref, err = getObjectRef(conn, objType, map[string]string{"start_addr": scope, "network_view": netView})
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to find '%s' in network view '%s': %w", scope, netView, err))
+	}
+
+	ipamStats := &ibclient.IpamStatistics{}
+	if objType != "range" {
+		if ipamStats, err = getIpamStatistics(conn, network, netView); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get the IPAM statistics of %s '%s': %w", objType, scope, err))
+		}
+	}
+	// NIOS keeps the DHCP statistics of networks and ranges only.
+	dhcpStats := &ibclient.DhcpStatistics{}
+	if objType != "networkcontainer" {
+		if dhcpStats, err = getDhcpStatistics(conn, ref); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get the DHCP statistics of %s '%s': %w", objType, scope, err))
+		}
+	}
+
+	updated := ""
+	if ipamStats.UtilizationUpdate != nil {
+		updated = ipamStats.UtilizationUpdate.UTC().Format(time.RFC3339)
+	}
+	values := map[string]interface{}{
+		"object_type":             objType,
+		"utilization":             float64(ipamStats.Utilization),
+		"unmanaged_count":         int(ipamStats.UnmanagedCount),
+		"conflict_count":          int(ipamStats.ConflictCount),
+		"utilization_updated":     updated,
+		"dhcp_utilization":        float64(dhcpStats.DhcpUtilization) / dhcpUtilizationScale,
+		"dhcp_utilization_status": dhcpStats.DhcpUtilizationStatus,
+		"total_hosts":             int(dhcpStats.TotalHosts),
+		"used_hosts":              int(dhcpStats.StaticHosts + dhcpStats.DynamicHosts),
+		"static_hosts":            int(dhcpStats.StaticHosts),
+		"dynamic_hosts":           int(dhcpStats.DynamicHosts),
+	}
+	for field, value := range values {
+		if err = d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(ref)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceNetworkUtilization(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "container" {
+						cidr = "10.37.0.0/16"
+					}
+
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.37.1.0/24"
+						depends_on = [infoblox_ipv4_network_container.container]
+					}
+
+					resource "infoblox_ipv4_range" "range" {
+						start_addr = "10.37.1.100"
+						end_addr = "10.37.1.149"
+						network = infoblox_ipv4_network.net.cidr
+					}
+
+					data "infoblox_network_utilization" "net" {
+						network = infoblox_ipv4_network.net.cidr
+					}
+
+					data "infoblox_network_utilization" "container" {
+						network = infoblox_ipv4_network_container.container.cidr
+					}
+
+					data "infoblox_network_utilization" "range" {
+						range_start_addr = infoblox_ipv4_range.range.start_addr
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_network_utilization.net", "object_type", "network"),
+					resource.TestCheckResourceAttrSet("data.infoblox_network_utilization.net", "utilization"),
+					resource.TestCheckResourceAttr("data.infoblox_network_utilization.container", "object_type", "networkcontainer"),
+					resource.TestCheckResourceAttr("data.infoblox_network_utilization.container", "total_hosts", "0"),
+					resource.TestCheckResourceAttr("data.infoblox_network_utilization.range", "object_type", "range"),
+					resource.TestCheckResourceAttr("data.infoblox_network_utilization.range", "total_hosts", "50"),
+					resource.TestCheckResourceAttr("data.infoblox_network_utilization.range", "used_hosts", "0"),
+				),
+			},
+		},
+	})
+}
@@ -5,15 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func dataSourceNetworkView() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceNetworkViewRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -46,11 +44,12 @@ func dataSourceNetworkView() *schema.Resource {
 							Computed:    true,
 							Description: "The Extensible attributes of the network view.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "network views")))
 }
 
 func dataSourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -61,18 +60,9 @@ func dataSourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m in
 	n := &ibclient.NetworkView{}
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs"))
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.NetworkView
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.NetworkView](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.NetworkView{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting network view failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting network view failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -87,14 +77,10 @@ func dataSourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m in
 		results = append(results, networkViewFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -111,8 +97,9 @@ func flattenNetworkView(nv ibclient.NetworkView) (map[string]interface{}, error)
 	}
 
 	res := map[string]interface{}{
-		"id":        nv.Ref,
-		"ext_attrs": string(ea),
+		"id":                    nv.Ref,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if nv.Name != nil {
@@ -0,0 +1,237 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"net"
+	"strings"
+)
+
+// maxNextAvailable is the maximum number of addresses or networks NIOS returns at once.
+const maxNextAvailable = 20
+
+// The data sources below only preview the next available addresses and networks:
+// the 'next_available_ip' and 'next_available_network' WAPI functions do not reserve anything,
+// so the returned values may be taken by another allocation before they are used.
+
+func dataSourceNextAvailableIP() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNextAvailableIPRead,
+		Schema: map[string]*schema.Schema{
+			"network": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"network", "range_start_addr"},
+				Description:  "The IPv4 or IPv6 network in CIDR format to find the next available addresses in.",
+			},
+			"range_start_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The start address of the IPv4 or IPv6 range to find the next available addresses in.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The network view of the network or range.",
+			},
+			"num": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, maxNextAvailable),
+				Description:  "The number of addresses to return.",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The addresses to exclude from the result.",
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The next available addresses; they are not reserved.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first of the next available addresses; it is not reserved.",
+			},
+		},
+	}
+}
+
+func dataSourceNextAvailableNetwork() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNextAvailableNetworkRead,
+		Schema: map[string]*schema.Schema{
+			"parent_cidr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IPv4 or IPv6 network container in CIDR format to find the next available networks in.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The network view of the network container.",
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 128),
+				Description:  "The prefix length of the networks to return.",
+			},
+			"num": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, maxNextAvailable),
+				Description:  "The number of networks to return.",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The networks in CIDR format to exclude from the result.",
+			},
+			"networks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The next available networks in CIDR format; they are not reserved.",
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first of the next available networks in CIDR format; it is not reserved.",
+			},
+		},
+	}
+}
+
+// isIPv6Address checks whether the address or network in CIDR format is an IPv6 one.
+func isIPv6Address(address string) bool {
+	ip := net.ParseIP(strings.SplitN(address, "/", 2)[0])
+
+	return ip != nil && ip.To4() == nil
+}
+
+// getObjectRef returns the reference of the only object of the given type matching the search fields.
+func getObjectRef(conn ibclient.IBConnector, objType string, searchFields map[string]string) (string, error) {
+	var res []struct {
+		Ref string `json:"_ref"`
+	}
+	err := conn.GetObject(newWapiRawObject(objType, nil), "", ibclient.NewQueryParams(false, searchFields), &res)
+	if err != nil && !isNotFoundError(err) {
+		return "", err
+	}
+	if len(res) == 0 {
+		return "", ibclient.NewNotFoundError(fmt.Sprintf("'%s' object not found", objType))
+	}
+
+	return res[0].Ref, nil
+}
+
+func nextAvailableArgs(d *schema.ResourceData) map[string]interface{} {
+	args := map[string]interface{}{
+		"num": d.Get("num").(int),
+	}
+	if exclude := expandStringList(d.Get("exclude").([]interface{})); len(exclude) > 0 {
+		args["exclude"] = exclude
+	}
+
+	return args
+}
+
+func dataSourceNextAvailableIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+	netView := d.Get("network_view").(string)
+
+	var objType, scope string
+	searchFields := map[string]string{"network_view": netView}
+	if network := d.Get("network").(string); network != "" {
+		objType, scope = "network", network
+		searchFields["network"] = network
+	} else {
+		objType, scope = "range", d.Get("range_start_addr").(string)
+		searchFields["start_addr"] = scope
+	}
+	if isIPv6Address(scope) {
+		objType = "ipv6" + objType
+	}
+
+	ref, err := getObjectRef(conn, objType, searchFields)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to find %s '%s' in network view '%s': %w", objType, scope, netView, err))
+	}
+
+	var res struct {
+		IPs []string `json:"ips"`
+	}
+	if err = callWapiFunction(conn, ref, "next_available_ip", nextAvailableArgs(d), &res); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get the next available addresses of %s '%s': %w", objType, scope, err))
+	}
+
+	if err = d.Set("ip_addresses", res.IPs); err != nil {
+		return diag.FromErr(err)
+	}
+	first := ""
+	if len(res.IPs) > 0 {
+		first = res.IPs[0]
+	}
+	if err = d.Set("ip_address", first); err != nil {
+		return diag.FromErr(err)
+	}
+	// The ID changes along with the available addresses of the network or range.
+	d.SetId(dataSourceResultsId(append([]string{ref}, res.IPs...)))
+
+	return nil
+}
+
+func dataSourceNextAvailableNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+	netView := d.Get("network_view").(string)
+	parentCidr := d.Get("parent_cidr").(string)
+
+	objType := "networkcontainer"
+	if isIPv6Address(parentCidr) {
+		objType = "ipv6networkcontainer"
+	}
+	ref, err := getObjectRef(conn, objType, map[string]string{"network": parentCidr, "network_view": netView})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"failed to find network container '%s' in network view '%s': %w", parentCidr, netView, err))
+	}
+
+	args := nextAvailableArgs(d)
+	args["cidr"] = d.Get("prefix_length").(int)
+	var res struct {
+		Networks []string `json:"networks"`
+	}
+	if err = callWapiFunction(conn, ref, "next_available_network", args, &res); err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"failed to get the next available networks of network container '%s': %w", parentCidr, err))
+	}
+
+	if err = d.Set("networks", res.Networks); err != nil {
+		return diag.FromErr(err)
+	}
+	first := ""
+	if len(res.Networks) > 0 {
+		first = res.Networks[0]
+	}
+	if err = d.Set("network", first); err != nil {
+		return diag.FromErr(err)
+	}
+	// The ID changes along with the available networks of the network container.
+	d.SetId(dataSourceResultsId(append([]string{ref}, res.Networks...)))
+
+	return nil
+}
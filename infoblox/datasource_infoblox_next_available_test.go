@@ -0,0 +1,86 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDataSourceNextAvailableIP(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.36.0.0/24"
+					}
+
+					resource "infoblox_ipv4_range" "range" {
+						start_addr = "10.36.0.100"
+						end_addr = "10.36.0.150"
+						network = infoblox_ipv4_network.net.cidr
+					}
+
+					data "infoblox_next_available_ip" "net" {
+						network = infoblox_ipv4_network.net.cidr
+						num = 3
+						exclude = ["10.36.0.1"]
+					}
+
+					data "infoblox_next_available_ip" "range" {
+						range_start_addr = infoblox_ipv4_range.range.start_addr
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_next_available_ip.net", "ip_addresses.#", "3"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_ip.net", "ip_address", "10.36.0.2"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_ip.range", "ip_address", "10.36.0.100"),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_next_available_ip" "missing" {
+						network = "10.36.99.0/24"
+					}`,
+				ExpectError: regexp.MustCompile("failed to find network '10.36.99.0/24'"),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceNextAvailableNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "nc" {
+						cidr = "10.37.0.0/16"
+					}
+
+					data "infoblox_next_available_network" "nets" {
+						parent_cidr = infoblox_ipv4_network_container.nc.cidr
+						prefix_length = 24
+						num = 2
+						exclude = ["10.37.0.0/24"]
+					}
+
+					resource "infoblox_ipv6_network_container" "nc6" {
+						cidr = "2001:db8:37::/48"
+					}
+
+					data "infoblox_next_available_network" "net6" {
+						parent_cidr = infoblox_ipv6_network_container.nc6.cidr
+						prefix_length = 64
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_next_available_network.nets", "networks.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_network.nets", "network", "10.37.1.0/24"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_network.net6", "network", "2001:db8:37::/64"),
+				),
+			},
+		},
+	})
+}
@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceNSRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceNSRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -97,7 +95,7 @@ func dataSourceNSRecord() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "NS records")))
 }
 
 func dataSourceNSRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -107,10 +105,10 @@ func dataSourceNSRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, err := objMgr.GetAllRecordNS(qp)
+	res, err := getPagedObjects[ibclient.RecordNS](d, connector, ibclient.NewEmptyRecordNS(), filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Getting NS Record failed with filters %v: %s", filters, err.Error()))
+	}
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
 	//       (avoiding additional layer of keys ("value" key)
 	results := make([]interface{}, 0, len(res))
@@ -123,13 +121,9 @@ func dataSourceNSRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 		results = append(results, recordaFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
-
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
 	return diags
 }
 
@@ -0,0 +1,86 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourcePermission() *schema.Resource {
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
+		ReadContext: dataSourcePermissionRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of permissions matching filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the admin group the permission applies to.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the admin role the permission applies to.",
+						},
+						"object": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference of the NIOS object the permission applies to.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the objects the permission applies to.",
+						},
+						"permission": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the permission: READ, WRITE or DENY.",
+						},
+					},
+				},
+			},
+		},
+	}, "permissions")))
+}
+
+func dataSourcePermissionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	obj := &ibclient.Permission{}
+	obj.SetReturnFields(permissionReturnFields)
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+	res, err := getPagedObjects[ibclient.Permission](d, connector, obj, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting permission failed: %w", err))
+	}
+
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		results = append(results, flattenPermission(r))
+	}
+
+	if err = setDataSourceResults(d, results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
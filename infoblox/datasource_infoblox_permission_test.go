@@ -0,0 +1,37 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourcePermission(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_admin_role" "test_role" {
+						name = "tf_acc_test_ds_permission_role"
+					}
+					resource "infoblox_permission" "test_permission" {
+						role = infoblox_admin_role.test_role.name
+						resource_type = "NETWORK"
+						permission = "DENY"
+					}
+					data "infoblox_permission" "acctest" {
+						filters = {
+							role = "tf_acc_test_ds_permission_role"
+						}
+						depends_on = [infoblox_permission.test_permission]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_permission.acctest", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_permission.acctest", "results.0.resource_type", "NETWORK"),
+					resource.TestCheckResourceAttr("data.infoblox_permission.acctest", "results.0.permission", "DENY"),
+				),
+			},
+		},
+	})
+}
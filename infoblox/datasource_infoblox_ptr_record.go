@@ -5,15 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func dataSourcePtrRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourcePtrRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -74,11 +72,12 @@ func dataSourcePtrRecord() *schema.Resource {
 							Computed:    true,
 							Description: "The Extensible attributes of the PTR-record.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "PTR records")))
 }
 
 func dataSourcePtrRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -90,18 +89,9 @@ func dataSourcePtrRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "zone", "comment", "name", "ipv4addr", "ipv6addr", "ttl"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.RecordPTR
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.RecordPTR](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.RecordPTR{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting PTR Record failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting PTR Record failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -116,14 +106,10 @@ func dataSourcePtrRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 		results = append(results, recordptrFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -141,10 +127,11 @@ func flattenRecordPTR(recordptr ibclient.RecordPTR) (map[string]interface{}, err
 	}
 
 	res := map[string]interface{}{
-		"id":        recordptr.Ref,
-		"dns_view":  recordptr.View,
-		"zone":      recordptr.Zone,
-		"ext_attrs": string(ea),
+		"id":                    recordptr.Ref,
+		"dns_view":              recordptr.View,
+		"zone":                  recordptr.Zone,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if recordptr.Ipv4Addr != nil || recordptr.Ipv6Addr != nil {
@@ -0,0 +1,200 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"strings"
+)
+
+// searchNameFields are the fields of the base objects returned by the search object,
+// which are tried in order to determine the name of an object.
+var searchNameFields = []string{"name", "fqdn", "network", "ipv4addr", "ipv6addr", "ip_address", "address", "start_addr"}
+
+func dataSourceSearch() *schema.Resource {
+	return withSingleObjectMode(&schema.Resource{
+		ReadContext: dataSourceSearchRead,
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"address", "mac_address", "fqdn", "search_string"},
+				Description:  "The IPv4 or IPv6 address to search the objects referencing it.",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The MAC address to search the objects referencing it.",
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The FQDN to search the objects by.",
+			},
+			"search_string": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The string to search the objects by; the names, comments and extensible attribute values are searched.",
+			},
+			"regex": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, 'fqdn' and 'search_string' are regular expressions.",
+			},
+			"object_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Search only the objects of the given types, for example 'record:host' or 'network'.",
+			},
+			"fetch_ext_attrs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the extensible attributes of the found objects are read as well, " +
+					"which requires a separate request per object.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the objects matching the arguments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference of the object.",
+						},
+						"object_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The WAPI type of the object, for example 'record:a'.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the object: its name, FQDN, network or address, depending on the object type.",
+						},
+						"network_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network view of the object, if any.",
+						},
+						"dns_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DNS view of the object, if any.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The extensible attributes of the object in JSON format, if 'fetch_ext_attrs' is true.",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// searchObjectType returns the object type of the object with the given reference.
+func searchObjectType(ref string) string {
+	return strings.SplitN(ref, "/", 2)[0]
+}
+
+// getObjectEAs returns the extensible attributes of the object with the given reference.
+func getObjectEAs(conn ibclient.IBConnector, ref string) (ibclient.EA, error) {
+	obj := newWapiRawObject(searchObjectType(ref), nil)
+	obj.SetReturnFields([]string{"extattrs"})
+	var res struct {
+		Ea ibclient.EA `json:"extattrs"`
+	}
+	if err := conn.GetObject(obj, ref, ibclient.NewQueryParams(false, nil), &res); err != nil {
+		return nil, err
+	}
+
+	return res.Ea, nil
+}
+
+func dataSourceSearchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	// NIOS interprets the '~' modifier of a search field as a regular expression.
+	modifier := ""
+	if d.Get("regex").(bool) {
+		modifier = "~"
+	}
+	filters := make(map[string]string)
+	for _, field := range []string{"address", "mac_address"} {
+		if v := d.Get(field).(string); v != "" {
+			filters[field] = v
+		}
+	}
+	for _, field := range []string{"fqdn", "search_string"} {
+		if v := d.Get(field).(string); v != "" {
+			filters[field+modifier] = v
+		}
+	}
+	// The values separated by commas are sent as separate 'objtype' parameters, which NIOS matches by any of them.
+	if objTypes := expandStringList(d.Get("object_types").([]interface{})); len(objTypes) > 0 {
+		filters["objtype"] = strings.Join(objTypes, ",")
+	}
+
+	var objects []map[string]interface{}
+	err := conn.GetObject(newWapiRawObject("search", nil), "", ibclient.NewQueryParams(false, filters), &objects)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(fmt.Errorf("search failed: %w", err))
+	}
+
+	fetchEAs := d.Get("fetch_ext_attrs").(bool)
+	results := make([]interface{}, 0, len(objects))
+	for _, obj := range objects {
+		res := flattenSearchResult(obj)
+		if fetchEAs {
+			ea, err := getObjectEAs(conn, res["id"].(string))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf(
+					"failed to read the extensible attributes of '%s': %w", res["id"], err))
+			}
+			if res["ext_attrs"], err = terraformSerializeEAs(ea); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		results = append(results, res)
+	}
+
+	if err = setDataSourceResults(d, results); err != nil {
+		return diag.FromErr(fmt.Errorf("search failed: %w", err))
+	}
+
+	return nil
+}
+
+func flattenSearchResult(obj map[string]interface{}) map[string]interface{} {
+	str := func(field string) string {
+		if v, ok := obj[field].(string); ok {
+			return v
+		}
+		return ""
+	}
+
+	name := ""
+	for _, field := range searchNameFields {
+		if name = str(field); name != "" {
+			break
+		}
+	}
+	ref := str("_ref")
+
+	return map[string]interface{}{
+		"id":           ref,
+		"object_type":  searchObjectType(ref),
+		"name":         name,
+		"network_view": str("network_view"),
+		"dns_view":     str("view"),
+		"ext_attrs":    "",
+	}
+}
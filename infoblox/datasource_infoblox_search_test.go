@@ -0,0 +1,77 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestFlattenSearchResult(t *testing.T) {
+	res := flattenSearchResult(map[string]interface{}{
+		"_ref":         "fixedaddress/ZG5zLmZpeGVkX2FkZHJlc3MkMTAuMzkuMC4xMC4wLi4:10.39.0.10/default",
+		"ipv4addr":     "10.39.0.10",
+		"network_view": "default",
+	})
+	if res["object_type"] != "fixedaddress" {
+		t.Errorf("expected 'fixedaddress' object type, got '%s'", res["object_type"])
+	}
+	if res["name"] != "10.39.0.10" {
+		t.Errorf("expected the address to be the name of a fixed address, got '%s'", res["name"])
+	}
+	if res["network_view"] != "default" || res["dns_view"] != "" {
+		t.Errorf("unexpected views: '%s', '%s'", res["network_view"], res["dns_view"])
+	}
+
+	res = flattenSearchResult(map[string]interface{}{
+		"_ref": "record:a/ZG5zLmJpbmRfYSQuXy5jb20udGVzdCxzZWFyY2gsMTAuMzkuMC4xMA:search.test.com/default",
+		"name": "search.test.com",
+		"view": "default",
+	})
+	if res["object_type"] != "record:a" || res["name"] != "search.test.com" || res["dns_view"] != "default" {
+		t.Errorf("unexpected result: %v", res)
+	}
+}
+
+func TestAccDataSourceSearch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "search.test.com"
+					}
+
+					resource "infoblox_a_record" "a" {
+						fqdn = "web1.${infoblox_zone_auth.zone.fqdn}"
+						ip_addr = "10.39.0.10"
+						ext_attrs = jsonencode({
+							"Location" = "search test"
+						})
+					}
+
+					data "infoblox_search" "address" {
+						address = infoblox_a_record.a.ip_addr
+						object_types = ["record:a"]
+						fetch_ext_attrs = true
+					}
+
+					data "infoblox_search" "regex" {
+						fqdn = "^web[0-9]+\\.search\\.test\\.com$"
+						regex = true
+						depends_on = [infoblox_a_record.a]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_search.address", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_search.address", "results.0.object_type", "record:a"),
+					resource.TestCheckResourceAttr("data.infoblox_search.address", "results.0.name", "web1.search.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_search.address", "results.0.dns_view", "default"),
+					resource.TestCheckResourceAttr(
+						"data.infoblox_search.address", "results.0.ext_attrs", `{"Location":"search test"}`),
+					resource.TestCheckResourceAttrPair(
+						"data.infoblox_search.regex", "results.0.id", "infoblox_a_record.a", "id"),
+				),
+			},
+		},
+	})
+}
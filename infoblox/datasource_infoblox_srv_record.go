@@ -5,8 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -14,7 +12,7 @@ import (
 )
 
 func dataSourceSRVRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceSRVRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -83,11 +81,12 @@ func dataSourceSRVRecord() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the SRV-record to be added/updated, as a map in JSON format.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "SRV records")))
 }
 
 func dataSourceSRVRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -99,18 +98,9 @@ func dataSourceSRVRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "zone", "comment", "ttl"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.RecordSRV
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.RecordSRV](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.RecordSRV{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting SRV Record failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting SRV Record failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -125,14 +115,10 @@ func dataSourceSRVRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 		results = append(results, recordsrvFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -149,10 +135,11 @@ func flattenRecordSRV(recordsrv ibclient.RecordSRV) (map[string]interface{}, err
 	}
 
 	res := map[string]interface{}{
-		"id":        recordsrv.Ref,
-		"dns_view":  recordsrv.View,
-		"zone":      recordsrv.Zone,
-		"ext_attrs": string(ea),
+		"id":                    recordsrv.Ref,
+		"dns_view":              recordsrv.View,
+		"zone":                  recordsrv.Zone,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if recordsrv.Port != nil {
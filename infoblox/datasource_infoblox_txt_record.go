@@ -5,15 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func dataSourceTXTRecord() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceTXTRecordRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -67,11 +65,12 @@ func dataSourceTXTRecord() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the TXT-record, as a map in JSON format.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "TXT records")))
 }
 
 func dataSourceTXTRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -83,18 +82,9 @@ func dataSourceTXTRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "zone", "comment", "ttl"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.RecordTXT
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.RecordTXT](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.RecordTXT{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting TXT Record failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting TXT Record failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -109,14 +99,10 @@ func dataSourceTXTRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 		results = append(results, recordtxtFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -133,9 +119,10 @@ func flattenRecordTXT(recordtxt ibclient.RecordTXT) (map[string]interface{}, err
 	}
 
 	res := map[string]interface{}{
-		"id":        recordtxt.Ref,
-		"zone":      recordtxt.Zone,
-		"ext_attrs": string(ea),
+		"id":                    recordtxt.Ref,
+		"zone":                  recordtxt.Zone,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if recordtxt.Text != nil {
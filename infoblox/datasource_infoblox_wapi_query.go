@@ -0,0 +1,199 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"sort"
+	"strings"
+)
+
+func dataSourceWapiQuery() *schema.Resource {
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
+		ReadContext: dataSourceWapiQueryRead,
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The WAPI type of the objects to search for, for example 'record:caa'.",
+			},
+			"search_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The WAPI search fields, with the optional modifiers in the names, " +
+					"for example 'name~' for a regular expression search.",
+			},
+			"return_fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The fields to return; the fields prefixed with '+' are returned in addition to " +
+					"the standard fields of the object type. The standard fields are returned by default.",
+			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The field to sort the results by. The results are returned in NIOS order by default. " +
+					"All the matching objects are fetched to be sorted before 'max_results' is applied.",
+			},
+			"sort_descending": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"sort_by"},
+				Description:  "If true, the results are sorted in descending order.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of the objects matching the search fields, each one as a map in JSON format.",
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The matching object as a map in JSON format, if 'expect_single' is true.",
+			},
+		},
+	}, "objects")))
+}
+
+// expandWapiReturnFields returns the fields to request from NIOS. The fields prefixed with '+'
+// extend the standard fields of the object type, which are taken from the WAPI schema.
+func expandWapiReturnFields(conn ibclient.IBConnector, objType string, fields []string) ([]string, error) {
+	res := make([]string, 0, len(fields))
+	extended := false
+	for _, f := range fields {
+		if strings.HasPrefix(f, "+") {
+			extended = true
+			f = strings.TrimPrefix(f, "+")
+		}
+		res = append(res, f)
+	}
+	if !extended {
+		return res, nil
+	}
+
+	schemaFields, err := getWapiSchemaFields(conn, objType)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range schemaFields {
+		if f.StandardField && !containsAny(res, []string{f.Name}) {
+			res = append(res, f.Name)
+		}
+	}
+
+	return res, nil
+}
+
+// compareJSONValues defines the order of the decoded JSON values when sorting the results:
+// missing values go first, then booleans, numbers and strings; other values are compared by their JSON text.
+func compareJSONValues(a, b interface{}) int {
+	rank := func(v interface{}) int {
+		switch v.(type) {
+		case nil:
+			return 0
+		case bool:
+			return 1
+		case float64:
+			return 2
+		case string:
+			return 3
+		default:
+			return 4
+		}
+	}
+	if ra, rb := rank(a), rank(b); ra != rb {
+		return ra - rb
+	}
+
+	switch av := a.(type) {
+	case nil:
+		return 0
+	case bool:
+		bv := b.(bool)
+		if av == bv {
+			return 0
+		}
+		if !av {
+			return -1
+		}
+		return 1
+	case float64:
+		bv := b.(float64)
+		if av < bv {
+			return -1
+		}
+		if av > bv {
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(av, b.(string))
+	default:
+		aj, _ := json.Marshal(a)
+		bj, _ := json.Marshal(b)
+		return strings.Compare(string(aj), string(bj))
+	}
+}
+
+func sortWapiObjects(objects []map[string]interface{}, field string, descending bool) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		c := compareJSONValues(objects[i][field], objects[j][field])
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+func dataSourceWapiQueryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+	objType := d.Get("object_type").(string)
+
+	returnFields, err := expandWapiReturnFields(connector, objType, expandStringList(d.Get("return_fields").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obj := newWapiRawObject(objType, nil)
+	obj.SetReturnFields(returnFields)
+
+	filters := filterFromMap(d.Get("search_fields").(map[string]interface{}))
+	var sortObjects func([]map[string]interface{})
+	if sortBy := d.Get("sort_by").(string); sortBy != "" {
+		sortObjects = func(objects []map[string]interface{}) {
+			sortWapiObjects(objects, sortBy, d.Get("sort_descending").(bool))
+		}
+	}
+	objects, err := getSortedPagedObjects[map[string]interface{}](d, connector, obj, filters, sortObjects)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting '%s' objects failed: %w", objType, err))
+	}
+
+	results := make([]interface{}, 0, len(objects))
+	refs := make([]string, 0, len(objects))
+	for _, o := range objects {
+		res, err := json.Marshal(o)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		results = append(results, string(res))
+		if ref, ok := o["_ref"].(string); ok {
+			refs = append(refs, ref)
+		}
+	}
+
+	err = setDataSourceResultRefs(d, results, refs, func(result interface{}) error {
+		return d.Set("result", result)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
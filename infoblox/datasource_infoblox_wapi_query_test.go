@@ -0,0 +1,113 @@
+package infoblox
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"testing"
+)
+
+func TestSortWapiObjects(t *testing.T) {
+	objects := []map[string]interface{}{
+		{"name": "b", "ttl": float64(30)},
+		{"name": "a", "ttl": float64(300)},
+		{"ttl": float64(3)},
+		{"name": "c", "ttl": false},
+	}
+
+	sortWapiObjects(objects, "name", false)
+	if objects[0]["name"] != nil || objects[1]["name"] != "a" || objects[3]["name"] != "c" {
+		t.Errorf("unexpected order by name: %v", objects)
+	}
+
+	sortWapiObjects(objects, "ttl", true)
+	if objects[0]["ttl"] != float64(300) || objects[2]["ttl"] != float64(3) || objects[3]["ttl"] != false {
+		t.Errorf("unexpected descending order by ttl: %v", objects)
+	}
+}
+
+func TestGetSortedPagedObjects(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceWapiQuery().Schema, map[string]interface{}{
+		"object_type": "namedacl",
+		"page_size":   2,
+		"max_results": 2,
+	})
+	conn := &testPagingConnector{objects: []string{"e", "d", "c", "b", "a"}}
+	sortByName := func(objects []map[string]interface{}) {
+		sortWapiObjects(objects, "name", false)
+	}
+
+	objects, err := getSortedPagedObjects[map[string]interface{}](d, conn, newWapiRawObject("namedacl", nil), nil, sortByName)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The first objects in the sort order must be returned, not the first fetched ones.
+	if fmt.Sprint(objects) != "[map[name:a] map[name:b]]" || !d.Get("truncated").(bool) {
+		t.Errorf("expected the first 2 of all the sorted objects, got %v, truncated %t", objects, d.Get("truncated"))
+	}
+	if len(conn.requests) != 3 {
+		t.Errorf("expected all the pages to be fetched, got %d requests", len(conn.requests))
+	}
+}
+
+func TestAccDataSourceWapiQuery(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_network_view" "view1" {
+						name = "tf_acc_test_wapi_query_1"
+						comment = "first view"
+						ext_attrs = jsonencode({
+							"Site" = "wapi query test"
+						})
+					}
+
+					resource "infoblox_network_view" "view2" {
+						name = "tf_acc_test_wapi_query_2"
+						comment = "second view"
+						ext_attrs = jsonencode({
+							"Site" = "wapi query test"
+						})
+					}
+
+					data "infoblox_wapi_query" "views" {
+						object_type = "networkview"
+						search_fields = {
+							"*Site" = "wapi query test"
+						}
+						return_fields = ["+extattrs"]
+						sort_by = "name"
+						sort_descending = true
+
+						depends_on = [infoblox_network_view.view1, infoblox_network_view.view2]
+					}
+
+					data "infoblox_wapi_query" "limited" {
+						object_type = "networkview"
+						search_fields = {
+							"name~" = "^tf_acc_test_wapi_query_"
+						}
+						return_fields = ["name"]
+						page_size = 1
+						max_results = 1
+
+						depends_on = [infoblox_network_view.view1, infoblox_network_view.view2]
+					}
+
+					output "first_name" {
+						value = jsondecode(data.infoblox_wapi_query.views.results[0]).name
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_wapi_query.views", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_wapi_query.views", "truncated", "false"),
+					resource.TestCheckOutput("first_name", "tf_acc_test_wapi_query_2"),
+					resource.TestCheckResourceAttr("data.infoblox_wapi_query.limited", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_wapi_query.limited", "truncated", "true"),
+				),
+			},
+		},
+	})
+}
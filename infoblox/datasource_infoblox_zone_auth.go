@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceZoneAuth() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceZoneAuthRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -63,11 +61,12 @@ func dataSourceZoneAuth() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the zone, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 					},
 				},
 			},
 		},
-	}
+	}, "zones")))
 }
 
 func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -79,18 +78,9 @@ func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m inter
 	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "comment", "zone_format", "ns_group"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
-	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.ZoneAuth
-
-	err := connector.GetObject(n, "", qp, &res)
+	res, err := getPagedObjects[ibclient.ZoneAuth](d, connector, n, filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.ZoneAuth{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting Zone Auth failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting Zone Auth failed with filters %v: %s", filters, err.Error()))
 	}
 
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
@@ -105,14 +95,10 @@ func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m inter
 		results = append(results, zoneauthFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 }
 
@@ -130,10 +116,11 @@ func flattenZoneAuth(zoneauth ibclient.ZoneAuth) (map[string]interface{}, error)
 	}
 
 	res := map[string]interface{}{
-		"id":          zoneauth.Ref,
-		"zone_format": zoneauth.ZoneFormat,
-		"fqdn":        zoneauth.Fqdn,
-		"ext_attrs":   string(ea),
+		"id":                    zoneauth.Ref,
+		"zone_format":           zoneauth.ZoneFormat,
+		"fqdn":                  zoneauth.Fqdn,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
 	}
 
 	if zoneauth.View != nil {
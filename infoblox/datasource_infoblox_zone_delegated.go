@@ -7,12 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceZoneDelegated() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceZoneDelegatedRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -88,6 +86,7 @@ func dataSourceZoneDelegated() *schema.Resource {
 							Optional:    true,
 							Description: "Extensible attributes, as a map in JSON format",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"view": {
 							Type:        schema.TypeString,
 							Optional:    true,
@@ -104,7 +103,7 @@ func dataSourceZoneDelegated() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "zones")))
 }
 
 func dataSourceZoneDelegatedRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -114,18 +113,9 @@ func dataSourceZoneDelegatedRead(_ context.Context, d *schema.ResourceData, m in
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, err := objMgr.GetZoneDelegatedByFilters(qp)
+	res, err := getPagedObjects[ibclient.ZoneDelegated](d, connector, ibclient.NewEmptyZoneDelegated(), filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.ZoneDelegated{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting Record failed with filters %v: %s", filters, err.Error()))
-		}
+		return diag.FromErr(fmt.Errorf("Getting Record failed with filters %v: %s", filters, err.Error()))
 	}
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
 	//       (avoiding additional layer of keys ("value" key)
@@ -138,14 +128,10 @@ func dataSourceZoneDelegatedRead(_ context.Context, d *schema.ResourceData, m in
 		results = append(results, zoneDelegatedFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 
 }
@@ -164,11 +150,12 @@ func flattenZoneDelegated(zoneDelegated ibclient.ZoneDelegated) (map[string]inte
 	}
 
 	res := map[string]interface{}{
-		"id":          zoneDelegated.Ref,
-		"fqdn":        zoneDelegated.Fqdn,
-		"ext_attrs":   string(ea),
-		"zone_format": zoneDelegated.ZoneFormat,
-		"view":        *zoneDelegated.View,
+		"id":                    zoneDelegated.Ref,
+		"fqdn":                  zoneDelegated.Fqdn,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
+		"zone_format":           zoneDelegated.ZoneFormat,
+		"view":                  *zoneDelegated.View,
 	}
 	if zoneDelegated.Comment != nil {
 		res["comment"] = *zoneDelegated.Comment
@@ -8,12 +8,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
-	"strconv"
-	"time"
 )
 
 func dataSourceZoneForward() *schema.Resource {
-	return &schema.Resource{
+	return withSingleObjectMode(withFilterBlocks(withPaging(&schema.Resource{
 		ReadContext: dataSourceZoneForwardRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
@@ -92,6 +90,7 @@ func dataSourceZoneForward() *schema.Resource {
 							Optional:    true,
 							Description: "Extensible attributes of the zone forward to be added/updated, as a map in JSON format.",
 						},
+						"extensible_attributes": dataSourceExtensibleAttributesSchema(),
 						"forwarders_only": {
 							Type:        schema.TypeBool,
 							Optional:    true,
@@ -146,7 +145,7 @@ func dataSourceZoneForward() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "zones")))
 }
 
 func dataSourceZoneForwardRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -156,18 +155,9 @@ func dataSourceZoneForwardRead(_ context.Context, d *schema.ResourceData, m inte
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
-
-	qp := ibclient.NewQueryParams(false, filters)
-	res, err := objMgr.GetZoneForwardFilters(qp)
+	res, err := getPagedObjects[ibclient.ZoneForward](d, connector, ibclient.NewEmptyZoneForward(), filters)
 	if err != nil {
-		// Check if it's a "not found" error for data source - this is acceptable
-		if _, ok := err.(*ibclient.NotFoundError); ok {
-			// For data sources, empty results are valid - just return empty results
-			res = []ibclient.ZoneForward{}
-		} else {
-			return diag.FromErr(fmt.Errorf("Getting Zone Forward failed with filters %v: %s", filters, err))
-		}
+		return diag.FromErr(fmt.Errorf("Getting Zone Forward failed with filters %v: %s", filters, err))
 	}
 	// TODO: temporary scaffold, need to rework marshalling/unmarshalling of EAs
 	//       (avoiding additional layer of keys ("value" key)
@@ -180,14 +170,10 @@ func dataSourceZoneForwardRead(_ context.Context, d *schema.ResourceData, m inte
 		results = append(results, zfFlat)
 	}
 
-	err = d.Set("results", results)
-	if err != nil {
+	if err = setDataSourceResults(d, results); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// always run
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return diags
 
 }
@@ -206,11 +192,12 @@ func flattenZoneForward(zf ibclient.ZoneForward) (map[string]interface{}, error)
 	}
 
 	res := map[string]interface{}{
-		"id":          zf.Ref,
-		"fqdn":        zf.Fqdn,
-		"ext_attrs":   string(ea),
-		"zone_format": zf.ZoneFormat,
-		"view":        *zf.View,
+		"id":                    zf.Ref,
+		"fqdn":                  zf.Fqdn,
+		"ext_attrs":             string(ea),
+		"extensible_attributes": flattenExtensibleAttributes(eaMap, nil),
+		"zone_format":           zf.ZoneFormat,
+		"view":                  *zf.View,
 	}
 	if zf.Comment != nil {
 		res["comment"] = *zf.Comment
@@ -0,0 +1,168 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var allRecordsReturnFields = []string{
+	"name", "type", "address", "ttl", "disable", "creator", "record", "comment", "view", "zone"}
+
+func dataSourceZoneRecords() *schema.Resource {
+	return withSingleObjectMode(withPaging(&schema.Resource{
+		ReadContext: dataSourceZoneRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The FQDN of the zone to return the records of.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "The DNS view of the zone.",
+			},
+			"types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Return only the records of the given types, for example 'record:a' or 'record:cname'.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the records of the zone matching the arguments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the record, relative to the zone.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the record, for example 'record:a'; 'UNSUPPORTED' for the records not supported by WAPI.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address or target of the record, for example the IP address of an A record or the canonical name of a CNAME record.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The TTL value of the record in seconds.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the record is disabled.",
+						},
+						"creator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creator of the record: STATIC, DYNAMIC or SYSTEM.",
+						},
+						"record": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference of the object owning the record; 'None' if the record is not supported by WAPI.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The comment of the record.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone the record belongs to.",
+						},
+						"dns_view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DNS view the record belongs to.",
+						},
+					},
+				},
+			},
+		},
+	}, "records"))
+}
+
+func dataSourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+	zone := d.Get("zone").(string)
+	dnsView := d.Get("dns_view").(string)
+	maxResults := d.Get("max_results").(int)
+
+	// The type of the allrecords object can be searched by a single value only,
+	// so the records of each of the types are fetched separately.
+	types := expandStringList(d.Get("types").([]interface{}))
+	if len(types) == 0 {
+		types = []string{""}
+	}
+
+	results := make([]interface{}, 0)
+	truncated := false
+	for _, recType := range types {
+		filters := map[string]string{"zone": zone, "view": dnsView}
+		if recType != "" {
+			filters["type"] = recType
+		}
+		obj := &ibclient.Allrecords{}
+		obj.SetReturnFields(allRecordsReturnFields)
+		pager := newWapiPager(connector, obj, filters, d.Get("page_size").(int))
+
+		for pager.hasNext() && !truncated {
+			var page []ibclient.Allrecords
+			if err := pager.next(&page); err != nil {
+				return diag.FromErr(fmt.Errorf("getting the records of zone '%s' in DNS view '%s' failed: %w", zone, dnsView, err))
+			}
+			for _, r := range page {
+				if maxResults > 0 && len(results) == maxResults {
+					truncated = true
+					break
+				}
+				results = append(results, flattenAllRecords(r))
+			}
+		}
+		if truncated {
+			break
+		}
+	}
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setDataSourceResults(d, results, "zone", "dns_view", "types"); err != nil {
+		return diag.FromErr(fmt.Errorf("getting the records of zone '%s' in DNS view '%s' failed: %w", zone, dnsView, err))
+	}
+
+	return nil
+}
+
+func flattenAllRecords(r ibclient.Allrecords) map[string]interface{} {
+	return map[string]interface{}{
+		"id":       r.Ref,
+		"name":     r.Name,
+		"type":     r.Type,
+		"address":  r.Address,
+		"ttl":      int(r.Ttl),
+		"disable":  r.Disable,
+		"creator":  r.Creator,
+		"record":   r.Record,
+		"comment":  r.Comment,
+		"zone":     r.Zone,
+		"dns_view": r.View,
+	}
+}
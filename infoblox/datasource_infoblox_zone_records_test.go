@@ -0,0 +1,56 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceZoneRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "zonerecords.test.com"
+					}
+
+					resource "infoblox_a_record" "a" {
+						fqdn = "a.${infoblox_zone_auth.zone.fqdn}"
+						ip_addr = "10.38.0.1"
+						ttl = 300
+					}
+
+					resource "infoblox_cname_record" "cname" {
+						alias = "cname.${infoblox_zone_auth.zone.fqdn}"
+						canonical = infoblox_a_record.a.fqdn
+					}
+
+					data "infoblox_zone_records" "a" {
+						zone = infoblox_zone_auth.zone.fqdn
+						types = ["record:a"]
+						depends_on = [infoblox_a_record.a, infoblox_cname_record.cname]
+					}
+
+					data "infoblox_zone_records" "limited" {
+						zone = infoblox_zone_auth.zone.fqdn
+						types = ["record:a", "record:cname"]
+						max_results = 1
+						depends_on = [infoblox_a_record.a, infoblox_cname_record.cname]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "results.0.name", "a"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "results.0.address", "10.38.0.1"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "results.0.ttl", "300"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.a", "results.0.creator", "STATIC"),
+					resource.TestCheckResourceAttrPair(
+						"data.infoblox_zone_records.a", "results.0.record", "infoblox_a_record.a", "id"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.limited", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_records.limited", "truncated", "true"),
+				),
+			},
+		},
+	})
+}
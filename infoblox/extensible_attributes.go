@@ -0,0 +1,904 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Types of extensible attribute values, as defined by NIOS EA definitions.
+const (
+	eaTypeString  = "STRING"
+	eaTypeInteger = "INTEGER"
+	eaTypeEmail   = "EMAIL"
+	eaTypeURL     = "URL"
+	eaTypeDate    = "DATE"
+	eaTypeEnum    = "ENUM"
+)
+
+var eaTypes = []string{eaTypeString, eaTypeInteger, eaTypeEmail, eaTypeURL, eaTypeDate, eaTypeEnum}
+
+// Inheritance operations of extensible attributes, as defined by NIOS.
+// INHERIT makes an object take the value of an EA from its parent object,
+// DELETE removes an inherited EA from an object, UPDATE overrides an inherited value
+// with the one specified for the object.
+const (
+	eaInheritanceInherit = "INHERIT"
+	eaInheritanceDelete  = "DELETE"
+	eaInheritanceUpdate  = "UPDATE"
+)
+
+var eaInheritanceOperations = []string{eaInheritanceInherit, eaInheritanceDelete, eaInheritanceUpdate}
+
+// extensibleAttributesSchema returns the schema of the typed 'extensible_attributes' block,
+// an alternative to the JSON-formatted 'ext_attrs' field.
+// When the block is not specified, it mirrors the content of 'ext_attrs'.
+func extensibleAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeSet,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"ext_attrs"},
+		Description: "Extensible attributes of the object, as a set of typed blocks. " +
+			"Cannot be used together with 'ext_attrs'.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the extensible attribute.",
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      eaTypeString,
+					ValidateFunc: validation.StringInSlice(eaTypes, false),
+					Description:  "The type of the extensible attribute's value: STRING, INTEGER, EMAIL, URL, DATE or ENUM.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The value of the extensible attribute.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The list of values of a multi-value extensible attribute.",
+				},
+				"inheritance_operation": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(eaInheritanceOperations, false),
+					Description: "The inheritance operation for the extensible attribute: INHERIT to take the value " +
+						"from the parent object, DELETE to remove the inherited extensible attribute from the object, " +
+						"UPDATE to override the inherited value with the one specified. " +
+						"'value' and 'values' must be empty for INHERIT and DELETE operations. " +
+						"Supported only by the objects which may inherit extensible attributes: " +
+						"networks, network containers, ranges and fixed addresses.",
+				},
+			},
+		},
+	}
+}
+
+// defaultExtensibleAttributesSchema returns the schema of the provider's 'default_ext_attrs' blocks,
+// which are the same as 'extensible_attributes' blocks without the inheritance operation.
+func defaultExtensibleAttributesSchema() *schema.Resource {
+	res := extensibleAttributesSchema().Elem.(*schema.Resource)
+	delete(res.Schema, "inheritance_operation")
+
+	return res
+}
+
+// dataSourceExtensibleAttributesSchema returns the schema of the computed
+// 'extensible_attributes' field of data source results.
+func dataSourceExtensibleAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Extensible attributes of the object, as a list of typed blocks.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// withExtensibleAttributes adds the 'extensible_attributes' block to a resource
+// which has the 'ext_attrs' field, along with the state upgrader which fills the block
+// in from 'ext_attrs' for the states created by the previous versions of the plugin.
+// The NIOS objects of the resource do not inherit EAs, so the inheritance operations are rejected.
+func withExtensibleAttributes(r *schema.Resource) *schema.Resource {
+	return addExtensibleAttributes(r, false)
+}
+
+// withInheritableExtensibleAttributes is the same as withExtensibleAttributes, but for a resource
+// whose NIOS objects may inherit EAs from their parent objects.
+func withInheritableExtensibleAttributes(r *schema.Resource) *schema.Resource {
+	return addExtensibleAttributes(r, true)
+}
+
+func addExtensibleAttributes(r *schema.Resource, inheritable bool) *schema.Resource {
+	schemaV0 := make(map[string]*schema.Schema, len(r.Schema))
+	for k, v := range r.Schema {
+		schemaV0[k] = v
+	}
+	r.Schema["extensible_attributes"] = extensibleAttributesSchema()
+	r.Schema["all_ext_attrs"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: "All the extensible attributes managed by the resource, including the default ones" +
+			" specified at the provider level, as a map in JSON format.",
+	}
+
+	r.SchemaVersion = 1
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    (&schema.Resource{Schema: schemaV0}).CoreConfigSchema().ImpliedType(),
+			Upgrade: extAttrsStateUpgradeV0,
+		},
+	}
+
+	customizeDiffs := []schema.CustomizeDiffFunc{customizeDiffExtensibleAttributes, customizeDiffAllExtAttrs}
+	if !inheritable {
+		customizeDiffs = append(customizeDiffs, customizeDiffEAInheritanceUnsupported)
+	}
+	if r.CustomizeDiff != nil {
+		customizeDiffs = append([]schema.CustomizeDiffFunc{r.CustomizeDiff}, customizeDiffs...)
+	}
+	r.CustomizeDiff = customdiff.All(customizeDiffs...)
+
+	return r
+}
+
+// extAttrsStateUpgradeV0 converts the JSON-formatted 'ext_attrs' field into the 'extensible_attributes' block.
+func extAttrsStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	extAttrJSON, _ := rawState["ext_attrs"].(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return nil, err
+	}
+	rawState["extensible_attributes"] = flattenExtensibleAttributes(extAttrs, nil)
+	rawState["all_ext_attrs"], err = terraformSerializeEAs(extAttrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawState, nil
+}
+
+// customizeDiffExtensibleAttributes keeps the 'extensible_attributes' block in sync with 'ext_attrs'
+// when the block is not specified in the configuration.
+func customizeDiffExtensibleAttributes(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		// Without the configuration it is not possible to say which of the fields is in use.
+		if d.Get("ext_attrs").(string) == "" {
+			return nil
+		}
+	} else {
+		eaBlocks := rawConfig.GetAttr("extensible_attributes")
+		if !eaBlocks.IsKnown() {
+			return nil
+		}
+		if !eaBlocks.IsNull() && eaBlocks.LengthInt() > 0 {
+			return nil
+		}
+	}
+
+	if !d.NewValueKnown("ext_attrs") {
+		return d.SetNewComputed("extensible_attributes")
+	}
+
+	extAttrs, err := terraformDeserializeEAs(d.Get("ext_attrs").(string))
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("extensible_attributes", flattenExtensibleAttributes(extAttrs, nil))
+}
+
+// customizeDiffEAInheritanceUnsupported rejects the inheritance operations of the EAs of a resource,
+// whose NIOS objects do not inherit EAs.
+func customizeDiffEAInheritanceUnsupported(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for _, b := range eaBlocksFromValue(d.Get("extensible_attributes")) {
+		eaBlock, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if op, _ := eaBlock["inheritance_operation"].(string); op != "" {
+			return fmt.Errorf("extensible attribute '%s' has '%s' inheritance operation, "+
+				"but the objects of the resource do not inherit extensible attributes", eaBlock["name"], op)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffAllExtAttrs plans the value of 'all_ext_attrs', so that a difference between
+// the provider's default EAs and the EAs of the NIOS object is shown as an update of the resource.
+func customizeDiffAllExtAttrs(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("ext_attrs") || !d.NewValueKnown("extensible_attributes") {
+		return d.SetNewComputed("all_ext_attrs")
+	}
+
+	extAttrs, err := terraformEAsFromValues(
+		d.Get("ext_attrs").(string), eaBlocksFromValue(d.Get("extensible_attributes")))
+	if err != nil {
+		return err
+	}
+	allEAsJSON, err := terraformSerializeEAs(withDefaultEAs(extAttrs, defaultEAsFromMeta(m)))
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("all_ext_attrs", allEAsJSON)
+}
+
+// expandExtensibleAttributes converts the content of the 'extensible_attributes' block to a map of EAs,
+// with the values converted according to their types.
+func expandExtensibleAttributes(eaBlocks []interface{}) (map[string]interface{}, error) {
+	extAttrs := make(map[string]interface{}, len(eaBlocks))
+	names := make(map[string]struct{}, len(eaBlocks))
+	for _, b := range eaBlocks {
+		eaBlock, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := eaBlock["name"].(string)
+		if _, found := names[name]; found {
+			return nil, fmt.Errorf("extensible attribute '%s' is specified more than once", name)
+		}
+		names[name] = struct{}{}
+		eaType, _ := eaBlock["type"].(string)
+		value, _ := eaBlock["value"].(string)
+		values, _ := eaBlock["values"].([]interface{})
+
+		// Inherited and deleted EAs have no value of their own, they are sent by eaInheritanceConnector.
+		if op, _ := eaBlock["inheritance_operation"].(string); op == eaInheritanceInherit || op == eaInheritanceDelete {
+			if value != "" || len(values) > 0 {
+				return nil, fmt.Errorf(
+					"a value must not be specified for extensible attribute '%s' with '%s' inheritance operation", name, op)
+			}
+			continue
+		}
+
+		if len(values) > 0 {
+			if value != "" {
+				return nil, fmt.Errorf(
+					"only one of 'value' and 'values' may be specified for extensible attribute '%s'", name)
+			}
+			eaValues := make([]interface{}, 0, len(values))
+			for _, v := range values {
+				eaValue, err := convertEAValue(name, eaType, v.(string))
+				if err != nil {
+					return nil, err
+				}
+				eaValues = append(eaValues, eaValue)
+			}
+			extAttrs[name] = eaValues
+			continue
+		}
+
+		eaValue, err := convertEAValue(name, eaType, value)
+		if err != nil {
+			return nil, err
+		}
+		extAttrs[name] = eaValue
+	}
+
+	return extAttrs, nil
+}
+
+func convertEAValue(name, eaType, value string) (interface{}, error) {
+	if eaType != eaTypeInteger {
+		return value, nil
+	}
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("value '%s' of extensible attribute '%s' is not a valid integer", value, name)
+	}
+
+	return intValue, nil
+}
+
+// flattenExtensibleAttributes converts a map of EAs to the content of the 'extensible_attributes' block.
+// The types of string values are taken from prevBlocks, if present, since NIOS does not return them,
+// the same goes for the UPDATE inheritance operation.
+func flattenExtensibleAttributes(extAttrs map[string]interface{}, prevBlocks []interface{}) []interface{} {
+	prevTypes := make(map[string]string, len(prevBlocks))
+	prevUpdates := make(map[string]bool, len(prevBlocks))
+	for _, b := range prevBlocks {
+		if eaBlock, ok := b.(map[string]interface{}); ok {
+			name := eaBlock["name"].(string)
+			prevTypes[name], _ = eaBlock["type"].(string)
+			if op, _ := eaBlock["inheritance_operation"].(string); op == eaInheritanceUpdate {
+				prevUpdates[name] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(extAttrs))
+	for name := range extAttrs {
+		if name == eaNameForInternalId {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		eaType := eaTypeString
+		if prevType, found := prevTypes[name]; found && prevType != "" {
+			eaType = prevType
+		}
+		eaBlock := map[string]interface{}{
+			"name":   name,
+			"value":  "",
+			"values": []interface{}{},
+		}
+
+		switch val := extAttrs[name].(type) {
+		case []string:
+			values := make([]interface{}, 0, len(val))
+			for _, v := range val {
+				values = append(values, v)
+			}
+			eaBlock["values"] = values
+		case []interface{}:
+			values := make([]interface{}, 0, len(val))
+			for _, v := range val {
+				strVal, isInt := formatEAValue(v)
+				if isInt {
+					eaType = eaTypeInteger
+				}
+				values = append(values, strVal)
+			}
+			eaBlock["values"] = values
+		default:
+			strVal, isInt := formatEAValue(val)
+			if isInt {
+				eaType = eaTypeInteger
+			} else if eaType == eaTypeInteger {
+				eaType = eaTypeString
+			}
+			eaBlock["value"] = strVal
+		}
+		eaBlock["type"] = eaType
+		if prevUpdates[name] {
+			eaBlock["inheritance_operation"] = eaInheritanceUpdate
+		}
+
+		res = append(res, eaBlock)
+	}
+
+	return res
+}
+
+// formatEAValue returns a string representation of a single EA value
+// and reports whether the value is an integer one.
+func formatEAValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, false
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case json.Number:
+		return v.String(), true
+	case ibclient.Bool:
+		if v {
+			return "True", false
+		}
+		return "False", false
+	default:
+		return fmt.Sprintf("%v", v), false
+	}
+}
+
+// terraformGetEAs returns the EAs specified for the resource,
+// either in the 'ext_attrs' field or in the 'extensible_attributes' block.
+func terraformGetEAs(d *schema.ResourceData) (map[string]interface{}, error) {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	eaBlocks := eaBlocksFromValue(d.Get("extensible_attributes"))
+
+	return terraformEAsFromValues(extAttrJSON, eaBlocks)
+}
+
+// terraformGetEAsWithDefaults is the same as terraformGetEAs, but also includes
+// the default EAs specified at the provider level. Should be used in create functions.
+func terraformGetEAsWithDefaults(d *schema.ResourceData, m interface{}) (map[string]interface{}, error) {
+	extAttrs, err := terraformGetEAs(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return withDefaultEAs(extAttrs, defaultEAsFromMeta(m)), nil
+}
+
+// terraformGetEAsChange returns the old and the new set of EAs for update functions.
+// The new set includes the default EAs specified at the provider level,
+// the old one includes all the EAs which were previously managed by the resource.
+func terraformGetEAsChange(d *schema.ResourceData, m interface{}) (oldExtAttrs, newExtAttrs map[string]interface{}, err error) {
+	oldExtAttrJSON, newExtAttrJSON := d.GetChange("ext_attrs")
+	oldEABlocks, newEABlocks := d.GetChange("extensible_attributes")
+	oldAllExtAttrJSON, _ := d.GetChange("all_ext_attrs")
+
+	oldExtAttrs, err = terraformEAsFromValues(oldExtAttrJSON.(string), eaBlocksFromValue(oldEABlocks))
+	if err != nil {
+		return nil, nil, err
+	}
+	oldAllExtAttrs, err := terraformDeserializeEAs(oldAllExtAttrJSON.(string))
+	if err != nil {
+		return nil, nil, err
+	}
+	oldExtAttrs = withDefaultEAs(oldExtAttrs, oldAllExtAttrs)
+
+	newExtAttrs, err = terraformEAsFromValues(newExtAttrJSON.(string), eaBlocksFromValue(newEABlocks))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return oldExtAttrs, withDefaultEAs(newExtAttrs, defaultEAsFromMeta(m)), nil
+}
+
+func terraformEAsFromValues(extAttrJSON string, eaBlocks []interface{}) (map[string]interface{}, error) {
+	if extAttrJSON != "" || len(eaBlocks) == 0 {
+		return terraformDeserializeEAs(extAttrJSON)
+	}
+
+	return expandExtensibleAttributes(eaBlocks)
+}
+
+func eaBlocksFromValue(v interface{}) []interface{} {
+	if eaSet, ok := v.(*schema.Set); ok && eaSet != nil {
+		return eaSet.List()
+	}
+
+	return nil
+}
+
+// withDefaultEAs adds the default EAs to extAttrs, unless they are already present there.
+func withDefaultEAs(extAttrs, defaultEAs map[string]interface{}) map[string]interface{} {
+	if extAttrs == nil {
+		extAttrs = make(map[string]interface{}, len(defaultEAs))
+	}
+	for name, value := range defaultEAs {
+		if _, found := extAttrs[name]; !found {
+			extAttrs[name] = value
+		}
+	}
+
+	return extAttrs
+}
+
+// terraformSetEAs stores EAs read from NIOS in the resource's state: the EAs specified for the resource
+// are stored in the 'extensible_attributes' block and, if it is in use, in the 'ext_attrs' field;
+// the EAs specified for the resource along with the provider's default EAs are stored in 'all_ext_attrs'.
+func terraformSetEAs(d *schema.ResourceData, m interface{}, niosEAs map[string]interface{}) error {
+	return terraformSetInheritableEAs(d, m, niosEAs, nil)
+}
+
+// terraformSetInheritableEAs is the same as terraformSetEAs, but for objects which support
+// inheritance of EAs. inheritedEAs contains the names of the EAs which the NIOS object inherits
+// from its parent; nil means that this was not checked.
+// The blocks with INHERIT and DELETE inheritance operations are kept in the state as long as
+// the NIOS object is in line with them, since their values are not managed by the resource.
+func terraformSetInheritableEAs(
+	d *schema.ResourceData, m interface{}, niosEAs map[string]interface{}, inheritedEAs map[string]bool) error {
+
+	extAttrs, err := terraformGetEAs(d)
+	if err != nil {
+		return err
+	}
+
+	prevEABlocks := eaBlocksFromValue(d.Get("extensible_attributes"))
+	niosEAs = copyEAs(niosEAs)
+	var keptEABlocks []interface{}
+	for _, b := range prevEABlocks {
+		eaBlock, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := eaBlock["name"].(string)
+		op, _ := eaBlock["inheritance_operation"].(string)
+		_, onNios := niosEAs[name]
+		switch {
+		case op == eaInheritanceInherit && (inheritedEAs == nil || inheritedEAs[name]):
+			delete(niosEAs, name)
+			keptEABlocks = append(keptEABlocks, eaBlock)
+		case op == eaInheritanceDelete && !onNios:
+			keptEABlocks = append(keptEABlocks, eaBlock)
+		}
+	}
+
+	allExtAttrs := make(map[string]interface{}, len(niosEAs))
+	managedEAs := withDefaultEAs(extAttrs, defaultEAsFromMeta(m))
+	for name, value := range niosEAs {
+		if _, found := managedEAs[name]; found {
+			allExtAttrs[name] = value
+		}
+	}
+	allEAsJSON, err := terraformSerializeEAs(allExtAttrs)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("all_ext_attrs", allEAsJSON); err != nil {
+		return err
+	}
+
+	extAttrs, err = terraformGetEAs(d)
+	if err != nil {
+		return err
+	}
+	omittedEAs := omitEAs(niosEAs, extAttrs)
+
+	eaBlocks := append(flattenExtensibleAttributes(omittedEAs, prevEABlocks), keptEABlocks...)
+	if err = d.Set("extensible_attributes", eaBlocks); err != nil {
+		return err
+	}
+
+	if d.Get("ext_attrs").(string) == "" || len(omittedEAs) == 0 {
+		return nil
+	}
+	eaJSON, err := terraformSerializeEAs(omittedEAs)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("ext_attrs", eaJSON)
+}
+
+// terraformImportEAs stores all the EAs of an imported object
+// in the 'ext_attrs', 'extensible_attributes' and 'all_ext_attrs' fields.
+func terraformImportEAs(d *schema.ResourceData, extAttrs map[string]interface{}) error {
+	if len(extAttrs) == 0 {
+		return nil
+	}
+	eaJSON, err := terraformSerializeEAs(extAttrs)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("ext_attrs", eaJSON); err != nil {
+		return err
+	}
+	if err = d.Set("all_ext_attrs", eaJSON); err != nil {
+		return err
+	}
+
+	return d.Set("extensible_attributes", flattenExtensibleAttributes(extAttrs, nil))
+}
+
+func copyEAs(extAttrs map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(extAttrs))
+	for name, value := range extAttrs {
+		res[name] = value
+	}
+
+	return res
+}
+
+// eaDescendantsActionSchema returns the schema of the block which defines how changes of
+// the EAs of a parent object (a network container) are pushed down to its descendants.
+func eaDescendantsActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Defines how the changes of the extensible attributes of the object are propagated " +
+			"to its child networks, network containers and ranges.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"option_with_ea": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "RETAIN",
+					ValidateFunc: validation.StringInSlice([]string{"CONVERT", "INHERIT", "RETAIN"}, false),
+					Description: "What to do with descendants which have the extensible attribute with their own value: " +
+						"CONVERT the value to an inherited one if they are equal, always INHERIT the value, or RETAIN it.",
+				},
+				"option_without_ea": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "INHERIT",
+					ValidateFunc: validation.StringInSlice([]string{"INHERIT", "NOT_INHERIT"}, false),
+					Description: "What to do with descendants which do not have the extensible attribute: " +
+						"INHERIT it or NOT_INHERIT it.",
+				},
+			},
+		},
+	}
+}
+
+type eaDescendantsAction struct {
+	OptionWithEA    string `json:"option_with_ea,omitempty"`
+	OptionWithoutEA string `json:"option_without_ea,omitempty"`
+}
+
+type eaInheritanceSource struct {
+	Ref string `json:"_ref,omitempty"`
+}
+
+type eaWithInheritance struct {
+	Value                interface{}          `json:"value,omitempty"`
+	InheritanceOperation string               `json:"inheritance_operation,omitempty"`
+	InheritanceSource    *eaInheritanceSource `json:"inheritance_source,omitempty"`
+	DescendantsAction    *eaDescendantsAction `json:"descendants_action,omitempty"`
+}
+
+// eaInheritanceObject is used to read and write the EAs of any NIOS object along with their
+// inheritance properties, which are not supported by ibclient.EA.
+type eaInheritanceObject struct {
+	ibclient.IBBase `json:"-"`
+	objectType      string
+	Ref             string                        `json:"_ref,omitempty"`
+	Ea              map[string]*eaWithInheritance `json:"extattrs"`
+}
+
+func (obj *eaInheritanceObject) ObjectType() string {
+	return obj.objectType
+}
+
+func newEAInheritanceObject(ref string) *eaInheritanceObject {
+	return &eaInheritanceObject{objectType: strings.SplitN(ref, "/", 2)[0]}
+}
+
+// getEAsWithInheritance reads the EAs of the NIOS object with the given reference,
+// including the inherited ones along with their inheritance source.
+func getEAsWithInheritance(conn ibclient.IBConnector, ref string) (map[string]*eaWithInheritance, error) {
+	obj := newEAInheritanceObject(ref)
+	obj.SetReturnFields([]string{"extattrs"})
+	qp := ibclient.NewQueryParams(false, map[string]string{"_inheritance": "True"})
+
+	var res eaInheritanceObject
+	if err := conn.GetObject(obj, ref, qp, &res); err != nil {
+		return nil, fmt.Errorf("failed to read extensible attributes of '%s': %w", ref, err)
+	}
+
+	return res.Ea, nil
+}
+
+// getInheritedEAs returns the names of the EAs, which the NIOS object inherits from its parent.
+func getInheritedEAs(conn ibclient.IBConnector, ref string) (map[string]bool, error) {
+	eas, err := getEAsWithInheritance(conn, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return inheritedEANames(eas), nil
+}
+
+// inheritedEANames returns the names of the EAs, which have the inheritance source.
+func inheritedEANames(eas map[string]*eaWithInheritance) map[string]bool {
+	inheritedEAs := make(map[string]bool, len(eas))
+	for name, ea := range eas {
+		if ea != nil && ea.InheritanceSource != nil {
+			inheritedEAs[name] = true
+		}
+	}
+
+	return inheritedEAs
+}
+
+// readInheritedEAs is the same as getInheritedEAs, but reads the EAs from NIOS only when
+// the resource's state contains EAs with INHERIT inheritance operation, otherwise returns nil.
+func readInheritedEAs(d *schema.ResourceData, m interface{}, ref string) (map[string]bool, error) {
+	ops := eaBlocksInheritanceOperations(eaBlocksFromValue(d.Get("extensible_attributes")))
+	for _, op := range ops {
+		if op == eaInheritanceInherit {
+			return getInheritedEAs(m.(ibclient.IBConnector), ref)
+		}
+	}
+
+	return nil, nil
+}
+
+// removeInheritedEAs removes the EAs which the NIOS object inherits from its parent from extAttrs,
+// so that they are not considered as managed by the resource. Should be used for import operations.
+func removeInheritedEAs(m interface{}, ref string, extAttrs map[string]interface{}) error {
+	inheritedEAs, err := getInheritedEAs(m.(ibclient.IBConnector), ref)
+	if err != nil {
+		return err
+	}
+	for name := range inheritedEAs {
+		delete(extAttrs, name)
+	}
+
+	return nil
+}
+
+func eaBlocksInheritanceOperations(eaBlocks []interface{}) map[string]string {
+	ops := make(map[string]string, len(eaBlocks))
+	for _, b := range eaBlocks {
+		eaBlock, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if op, _ := eaBlock["inheritance_operation"].(string); op != "" {
+			ops[eaBlock["name"].(string)] = op
+		}
+	}
+
+	return ops
+}
+
+func expandEADescendantsAction(d *schema.ResourceData) *eaDescendantsAction {
+	blocks, ok := d.Get("ext_attrs_descendants_action").([]interface{})
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	return &eaDescendantsAction{
+		OptionWithEA:    block["option_with_ea"].(string),
+		OptionWithoutEA: block["option_without_ea"].(string),
+	}
+}
+
+// expandEAsWithInheritance returns the EAs to be sent to NIOS along with their inheritance properties:
+//   - the inheritance operations specified in the 'extensible_attributes' block;
+//   - INHERIT operation for the EAs, which the object inherited before the update
+//     (listed in inheritedEAs) and which are not managed by the resource;
+//   - 'ext_attrs_descendants_action', if the EAs are changed.
+//
+// extAttrs are the values of the EAs to be sent. nil is returned, if no EA has inheritance properties,
+// so that the plain values may be sent.
+func expandEAsWithInheritance(
+	d *schema.ResourceData, m interface{}, extAttrs map[string]interface{}, inheritedEAs map[string]bool,
+) (map[string]*eaWithInheritance, error) {
+
+	ops := eaBlocksInheritanceOperations(eaBlocksFromValue(d.Get("extensible_attributes")))
+	descendantsAction := expandEADescendantsAction(d)
+	if descendantsAction != nil && !d.HasChanges("ext_attrs", "extensible_attributes", "all_ext_attrs") {
+		descendantsAction = nil
+	}
+	if len(ops) == 0 && len(inheritedEAs) == 0 && descendantsAction == nil {
+		return nil, nil
+	}
+
+	managedEAs, err := terraformGetEAsWithDefaults(d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	eas := make(map[string]*eaWithInheritance, len(extAttrs)+len(ops))
+	for name, value := range extAttrs {
+		op := ops[name]
+		if op == eaInheritanceInherit || op == eaInheritanceDelete {
+			continue
+		}
+		if _, managed := managedEAs[name]; !managed && inheritedEAs[name] {
+			eas[name] = &eaWithInheritance{InheritanceOperation: eaInheritanceInherit}
+			continue
+		}
+		eas[name] = &eaWithInheritance{
+			Value:                value,
+			InheritanceOperation: op,
+			DescendantsAction:    descendantsAction,
+		}
+	}
+	for name, op := range ops {
+		if op == eaInheritanceInherit || op == eaInheritanceDelete {
+			eas[name] = &eaWithInheritance{InheritanceOperation: op}
+		}
+	}
+
+	return eas, nil
+}
+
+// eaInheritanceConnector sends the EAs of the NIOS objects of the given type along with
+// their inheritance properties, which ibclient.EA is not able to express, in the same request
+// which creates or updates an object. The requests for other objects are sent as is.
+type eaInheritanceConnector struct {
+	ibclient.IBConnector
+
+	objectType string
+	eas        map[string]*eaWithInheritance
+}
+
+// connectorWithEAInheritance returns the connector to create or update the NIOS object of the given type
+// with the EAs, so that their inheritance properties are sent along with them.
+// The connector itself is returned, if the EAs have no inheritance properties.
+func connectorWithEAInheritance(
+	conn ibclient.IBConnector, d *schema.ResourceData, m interface{}, objectType string,
+	extAttrs map[string]interface{}, inheritedEAs map[string]bool,
+) (ibclient.IBConnector, error) {
+
+	eas, err := expandEAsWithInheritance(d, m, extAttrs, inheritedEAs)
+	if err != nil || eas == nil {
+		return conn, err
+	}
+
+	return &eaInheritanceConnector{IBConnector: conn, objectType: objectType, eas: eas}, nil
+}
+
+func (c *eaInheritanceConnector) CreateObject(obj ibclient.IBObject) (string, error) {
+	if obj.ObjectType() != c.objectType {
+		return c.IBConnector.CreateObject(obj)
+	}
+	rawObj, err := c.withEAs(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return c.IBConnector.CreateObject(rawObj)
+}
+
+// UpdateObject checks the type of the object by its reference, since the go-client does not always set
+// the type of the objects it updates.
+func (c *eaInheritanceConnector) UpdateObject(obj ibclient.IBObject, ref string) (string, error) {
+	if strings.SplitN(ref, "/", 2)[0] != c.objectType {
+		return c.IBConnector.UpdateObject(obj, ref)
+	}
+	rawObj, err := c.withEAs(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return c.IBConnector.UpdateObject(rawObj, ref)
+}
+
+// withEAs converts the object into wapiRawObject with the same fields, but the EAs with inheritance.
+func (c *eaInheritanceConnector) withEAs(obj ibclient.IBObject) (*wapiRawObject, error) {
+	rawObj, err := newWapiRawObjectFrom(c.objectType, obj)
+	if err != nil {
+		return nil, err
+	}
+	rawObj.fields["extattrs"] = c.eas
+
+	return rawObj, nil
+}
+
+// getObjectWithInheritedEAs reads the NIOS object with the given reference into obj, along with
+// the inheritance properties of its EAs, and returns the names of the EAs which the object inherits
+// from its parent. The return fields of obj must include 'extattrs'.
+func getObjectWithInheritedEAs(conn ibclient.IBConnector, obj ibclient.IBObject, ref string) (map[string]bool, error) {
+	var data json.RawMessage
+	qp := ibclient.NewQueryParams(false, map[string]string{"_inheritance": "True"})
+	if err := conn.GetObject(obj, ref, qp, &data); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, fmt.Errorf("failed to parse the object '%s': %w", ref, err)
+	}
+	var res eaInheritanceObject
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("failed to parse extensible attributes of '%s': %w", ref, err)
+	}
+
+	return inheritedEANames(res.Ea), nil
+}
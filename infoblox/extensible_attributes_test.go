@@ -0,0 +1,328 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+	"reflect"
+	"testing"
+)
+
+func TestExpandExtensibleAttributes(t *testing.T) {
+	eaBlocks := []interface{}{
+		map[string]interface{}{"name": "Site", "type": "STRING", "value": "Nainital", "values": []interface{}{}},
+		map[string]interface{}{"name": "VLAN ID", "type": "INTEGER", "value": "100", "values": []interface{}{}},
+		map[string]interface{}{"name": "Owners", "type": "STRING", "value": "", "values": []interface{}{"net", "sec"}},
+	}
+	expected := map[string]interface{}{
+		"Site":    "Nainital",
+		"VLAN ID": 100,
+		"Owners":  []interface{}{"net", "sec"},
+	}
+
+	actual, err := expandExtensibleAttributes(eaBlocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+
+	invalidCases := [][]interface{}{
+		{map[string]interface{}{"name": "VLAN ID", "type": "INTEGER", "value": "one", "values": []interface{}{}}},
+		{map[string]interface{}{"name": "Site", "type": "STRING", "value": "a", "values": []interface{}{"b"}}},
+		{
+			map[string]interface{}{"name": "Site", "type": "STRING", "value": "a", "values": []interface{}{}},
+			map[string]interface{}{"name": "Site", "type": "STRING", "value": "b", "values": []interface{}{}},
+		},
+	}
+	for i, c := range invalidCases {
+		if _, err := expandExtensibleAttributes(c); err == nil {
+			t.Fatalf("expected test case %d to produce an error", i)
+		}
+	}
+}
+
+func TestFlattenExtensibleAttributes(t *testing.T) {
+	niosEAs := ibclient.EA{
+		eaNameForInternalId: "2b7aa4ea-0e1e-4c5d-9a8f-2d2b42f2b9a1",
+		"Site":              "Nainital",
+		"VLAN ID":           100,
+		"Owners":            []string{"net", "sec"},
+		"Contact":           "admin@example.com",
+	}
+	prevBlocks := []interface{}{
+		map[string]interface{}{"name": "Contact", "type": "EMAIL", "value": "old@example.com", "values": []interface{}{}},
+	}
+	expected := []interface{}{
+		map[string]interface{}{"name": "Contact", "type": "EMAIL", "value": "admin@example.com", "values": []interface{}{}},
+		map[string]interface{}{"name": "Owners", "type": "STRING", "value": "", "values": []interface{}{"net", "sec"}},
+		map[string]interface{}{"name": "Site", "type": "STRING", "value": "Nainital", "values": []interface{}{}},
+		map[string]interface{}{"name": "VLAN ID", "type": "INTEGER", "value": "100", "values": []interface{}{}},
+	}
+
+	actual := flattenExtensibleAttributes(niosEAs, prevBlocks)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+
+	// The values must be preserved after a round trip.
+	expanded, err := expandExtensibleAttributes(actual)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expanded["VLAN ID"] != 100 || expanded["Site"] != "Nainital" {
+		t.Fatalf("unexpected result of the round trip: %v", expanded)
+	}
+}
+
+func TestExpandExtensibleAttributesInheritance(t *testing.T) {
+	eaBlocks := []interface{}{
+		map[string]interface{}{"name": "Site", "type": "STRING", "value": "", "values": []interface{}{}, "inheritance_operation": "INHERIT"},
+		map[string]interface{}{"name": "Location", "type": "STRING", "value": "", "values": []interface{}{}, "inheritance_operation": "DELETE"},
+		map[string]interface{}{"name": "Owner", "type": "STRING", "value": "net-team", "values": []interface{}{}, "inheritance_operation": "UPDATE"},
+	}
+	expected := map[string]interface{}{
+		"Owner": "net-team",
+	}
+
+	actual, err := expandExtensibleAttributes(eaBlocks)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+	expectedOps := map[string]string{"Site": "INHERIT", "Location": "DELETE", "Owner": "UPDATE"}
+	if ops := eaBlocksInheritanceOperations(eaBlocks); !reflect.DeepEqual(ops, expectedOps) {
+		t.Fatalf("expected %v, got %v", expectedOps, ops)
+	}
+
+	invalidBlocks := []interface{}{
+		map[string]interface{}{"name": "Site", "type": "STRING", "value": "Nainital", "values": []interface{}{}, "inheritance_operation": "INHERIT"},
+	}
+	if _, err := expandExtensibleAttributes(invalidBlocks); err == nil {
+		t.Fatalf("expected an error for an inherited extensible attribute with a value")
+	}
+
+	// The UPDATE operation must be kept after reading the EAs from NIOS.
+	flattened := flattenExtensibleAttributes(ibclient.EA{"Owner": "net-team"}, eaBlocks)
+	if len(flattened) != 1 || flattened[0].(map[string]interface{})["inheritance_operation"] != "UPDATE" {
+		t.Fatalf("unexpected result of flattening: %v", flattened)
+	}
+}
+
+func TestTerraformSetInheritableEAs(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceIPv4NetworkContainer().Schema, map[string]interface{}{
+		"cidr": "10.0.0.0/24",
+		"extensible_attributes": []interface{}{
+			map[string]interface{}{"name": "Site", "inheritance_operation": "INHERIT"},
+			map[string]interface{}{"name": "Location", "inheritance_operation": "DELETE"},
+			map[string]interface{}{"name": "Owner", "value": "net-team"},
+		},
+	})
+	niosEAs := map[string]interface{}{
+		"Site":   "Nainital",
+		"Owner":  "net-team",
+		"Tenant": "inherited-tenant",
+	}
+
+	if err := terraformSetInheritableEAs(d, nil, niosEAs, map[string]bool{"Site": true, "Tenant": true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n := d.Get("extensible_attributes").(*schema.Set).Len(); n != 3 {
+		t.Fatalf("expected all 3 blocks to be kept in the state, got %d", n)
+	}
+	if allEAs := d.Get("all_ext_attrs").(string); allEAs != `{"Owner":"net-team"}` {
+		t.Fatalf("unexpected value of 'all_ext_attrs': %s", allEAs)
+	}
+	if len(niosEAs) != 3 {
+		t.Fatalf("the EAs read from NIOS must not be modified, got %v", niosEAs)
+	}
+
+	// The value of 'Site' has been overridden on NIOS side, this must be shown as a drift.
+	if err := terraformSetInheritableEAs(d, nil, niosEAs, map[string]bool{"Tenant": true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, b := range d.Get("extensible_attributes").(*schema.Set).List() {
+		if b.(map[string]interface{})["name"] == "Site" {
+			t.Fatalf("the block of the overridden extensible attribute must not be kept in the state")
+		}
+	}
+}
+
+func TestExtAttrsStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"name":      "test_view",
+		"ext_attrs": `{"Site":"Nainital","VLAN ID":100}`,
+	}
+	expected := []interface{}{
+		map[string]interface{}{"name": "Site", "type": "STRING", "value": "Nainital", "values": []interface{}{}},
+		map[string]interface{}{"name": "VLAN ID", "type": "INTEGER", "value": "100", "values": []interface{}{}},
+	}
+
+	actual, err := extAttrsStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual["ext_attrs"] != `{"Site":"Nainital","VLAN ID":100}` {
+		t.Fatalf("'ext_attrs' field must be kept as is, got %v", actual["ext_attrs"])
+	}
+	if !reflect.DeepEqual(actual["extensible_attributes"], expected) {
+		t.Fatalf("expected %v, got %v", expected, actual["extensible_attributes"])
+	}
+}
+
+func TestAccResourceDNSView_ExtensibleAttributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "infoblox_dns_view" "test_view" {
+						name = "test_view_typed_eas"
+						comment = "DNS view with typed extensible attributes"
+						extensible_attributes {
+							name = "Location"
+							value = "Test location"
+						}
+						extensible_attributes {
+							name = "Site"
+							value = "Test site"
+						}
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_dns_view.test_view", "ext_attrs", ""),
+					resource.TestCheckResourceAttr("infoblox_dns_view.test_view", "extensible_attributes.#", "2"),
+					validateDnsView("infoblox_dns_view.test_view", &ibclient.View{
+						Name:        utils.StringPtr("test_view_typed_eas"),
+						Comment:     utils.StringPtr("DNS view with typed extensible attributes"),
+						NetworkView: utils.StringPtr("default"),
+						Ea: map[string]interface{}{
+							"Location": "Test location",
+							"Site":     "Test site",
+						},
+					}),
+				),
+			},
+			// Switching to 'ext_attrs' must keep the same set of EAs.
+			{
+				Config: fmt.Sprintf(`
+					resource "infoblox_dns_view" "test_view" {
+						name = "test_view_typed_eas"
+						comment = "DNS view with typed extensible attributes"
+						ext_attrs = jsonencode({
+							Location = "Test location"
+						})
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_dns_view.test_view", "extensible_attributes.#", "1"),
+					validateDnsView("infoblox_dns_view.test_view", &ibclient.View{
+						Name:        utils.StringPtr("test_view_typed_eas"),
+						Comment:     utils.StringPtr("DNS view with typed extensible attributes"),
+						NetworkView: utils.StringPtr("default"),
+						Ea: map[string]interface{}{
+							"Location": "Test location",
+						},
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestWithDefaultEAs(t *testing.T) {
+	defaults := map[string]interface{}{
+		"Owner": "net-team",
+		"Site":  "Default site",
+	}
+	expected := map[string]interface{}{
+		"Owner": "net-team",
+		"Site":  "Nainital",
+	}
+
+	actual := withDefaultEAs(map[string]interface{}{"Site": "Nainital"}, defaults)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+	if defaults["Site"] != "Default site" {
+		t.Fatalf("the defaults must not be modified, got %v", defaults)
+	}
+
+	actual = withDefaultEAs(nil, defaults)
+	if !reflect.DeepEqual(actual, defaults) {
+		t.Fatalf("expected %v, got %v", defaults, actual)
+	}
+}
+
+func TestEAInheritanceOperationSupport(t *testing.T) {
+	eaBlocks := []interface{}{
+		map[string]interface{}{"name": "Site", "inheritance_operation": eaInheritanceInherit},
+	}
+
+	_, err := resourceARecord().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"fqdn":                  "a.test.com",
+		"ip_addr":               "10.0.0.1",
+		"extensible_attributes": eaBlocks,
+	}), nil)
+	if err == nil {
+		t.Errorf("expected the inheritance operation to be rejected for A-records")
+	}
+
+	_, err = resourceIPv4Network().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cidr":                  "10.0.0.0/24",
+		"extensible_attributes": eaBlocks,
+	}), nil)
+	if err != nil {
+		t.Errorf("expected the inheritance operation to be accepted for networks, got %s", err)
+	}
+}
+
+func TestConnectorWithEAInheritance(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceIPv4NetworkContainer().Schema, map[string]interface{}{
+		"cidr": "10.0.0.0/24",
+		"extensible_attributes": []interface{}{
+			map[string]interface{}{"name": "Site", "inheritance_operation": "INHERIT"},
+			map[string]interface{}{"name": "Owner", "value": "net-team"},
+		},
+	})
+	extAttrs := map[string]interface{}{"Owner": "net-team", "Tenant": "inherited-tenant"}
+	testConn := &testAdoptConnector{}
+
+	conn, err := connectorWithEAInheritance(testConn, d, nil, "networkcontainer", extAttrs, map[string]bool{"Tenant": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	nc := &ibclient.NetworkContainer{Comment: "test", Ea: extAttrs}
+	if _, err = conn.UpdateObject(nc, "networkcontainer/ZG5z:10.0.0.0/24/default"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := `networkcontainer/ZG5z:10.0.0.0/24/default {"comment":"test","extattrs":{` +
+		`"Owner":{"value":"net-team"},"Site":{"inheritance_operation":"INHERIT"},"Tenant":{"inheritance_operation":"INHERIT"}}}`
+	if len(testConn.updates) != 1 || testConn.updates[0] != expected {
+		t.Errorf("expected the EAs to be sent with their inheritance in the same request, got %v", testConn.updates)
+	}
+
+	// The requests for other objects must not be changed.
+	if _, err = conn.UpdateObject(&ibclient.Network{Comment: "test"}, "network/ZG5z:10.0.0.0/25/default"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(testConn.updates) != 2 || testConn.updates[1] != `network/ZG5z:10.0.0.0/25/default {"extattrs":{},"comment":"test"}` {
+		t.Errorf("expected the request for the network to be sent as is, got %v", testConn.updates)
+	}
+
+	// No wrapping is needed, if no EA has inheritance properties.
+	d = schema.TestResourceDataRaw(t, resourceIPv4NetworkContainer().Schema, map[string]interface{}{
+		"cidr":      "10.0.0.0/24",
+		"ext_attrs": `{"Owner":"net-team"}`,
+	})
+	if conn, err = connectorWithEAInheritance(testConn, d, nil, "networkcontainer", extAttrs, nil); err != nil || conn != testConn {
+		t.Errorf("expected the connector to be returned as is, got %v, %v", conn, err)
+	}
+}
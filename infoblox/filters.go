@@ -0,0 +1,204 @@
+package infoblox
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"sort"
+	"strings"
+)
+
+const defaultFilterOperator = "eq"
+
+// filterOperatorModifiers maps the operators of the 'filter' blocks to the WAPI search modifiers.
+// The 'in' operator is the equality on any of the comma-separated values, which are sent as repeated
+// query parameters of the same search field; on a list field it matches the objects with any of the values
+// in the list. Both 'eq' and 'in' use the search field without modifiers, so they cannot be combined on a field.
+var filterOperatorModifiers = map[string]string{
+	"eq":        "",
+	"in":        "",
+	"ne":        "!",
+	"ieq":       ":",
+	"ine":       "!:",
+	"regex":     "~",
+	"not_regex": "!~",
+	"le":        "<",
+	"ge":        ">",
+}
+
+func filterOperators() []string {
+	res := make([]string, 0, len(filterOperatorModifiers))
+	for op := range filterOperatorModifiers {
+		res = append(res, op)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// withFilterBlocks adds the 'filter' blocks to the schema of a data source, as a structured
+// alternative to the 'filters' map: exactly one of them must be specified.
+func withFilterBlocks(r *schema.Resource) *schema.Resource {
+	var exactlyOneOf []string
+	if filters, ok := r.Schema["filters"]; ok {
+		exactlyOneOf = []string{"filters", "filter"}
+		filters.Required = false
+		filters.Optional = true
+		filters.ExactlyOneOf = exactlyOneOf
+	}
+
+	r.Schema["filter"] = &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ExactlyOneOf: exactlyOneOf,
+		Description: "The conditions the objects must match, as an alternative to 'filters'. " +
+			"The conditions on the same field with the same operator are not allowed.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the WAPI field, or the name of the extensible attribute if 'ea' is true.",
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      defaultFilterOperator,
+					ValidateFunc: validation.StringInSlice(filterOperators(), false),
+					Description: "The comparison operator: 'eq', 'in' for any of the comma-separated values, 'ne', " +
+						"'ieq' and 'ine' for the case-insensitive (in)equality, 'regex', 'not_regex', 'le' and 'ge'.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The value to compare the field with.",
+				},
+				"ea": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "If true, 'field' is the name of an extensible attribute.",
+				},
+			},
+		},
+	}
+
+	return r
+}
+
+// searchFieldOfFilter returns the name of the WAPI search field of the filter, with the search modifiers.
+func searchFieldOfFilter(field, operator string, isEA bool) string {
+	if isEA {
+		field = "*" + field
+	}
+
+	return field + filterOperatorModifiers[operator]
+}
+
+// checkFilterOperator checks whether the field can be searched with the operator,
+// given the search modifiers listed in the WAPI schema of the field.
+func checkFilterOperator(field, operator, searchableBy string) error {
+	required := filterOperatorModifiers[operator]
+	if required == "" {
+		required = "="
+	}
+	for _, m := range required {
+		if !strings.ContainsRune(searchableBy, m) {
+			if searchableBy == "" {
+				return fmt.Errorf("field '%s' is not searchable", field)
+			}
+			return fmt.Errorf("field '%s' does not support '%s' operator, the supported search modifiers are '%s'",
+				field, operator, searchableBy)
+		}
+	}
+
+	return nil
+}
+
+// expandFilterBlocks adds the conditions of the 'filter' blocks to the search fields.
+// The operators of the conditions on the object's fields are checked against the WAPI schema of the object type.
+func expandFilterBlocks(
+	conn ibclient.IBConnector, objType string, blocks []interface{}, searchFields map[string]string) (map[string]string, error) {
+
+	res := make(map[string]string, len(searchFields)+len(blocks))
+	for k, v := range searchFields {
+		res[k] = v
+	}
+
+	var searchableBy map[string]string
+	operators := make(map[string]string, len(blocks))
+	for _, b := range blocks {
+		block, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		field := block["field"].(string)
+		operator := block["operator"].(string)
+		isEA := block["ea"].(bool)
+		if operator == "" {
+			operator = defaultFilterOperator
+		}
+		if _, ok := filterOperatorModifiers[operator]; !ok {
+			return nil, fmt.Errorf("unsupported filter operator '%s'", operator)
+		}
+
+		if !isEA {
+			if searchableBy == nil {
+				schemaFields, err := getWapiSchemaFields(conn, objType)
+				if err != nil {
+					return nil, err
+				}
+				searchableBy = make(map[string]string, len(schemaFields))
+				for _, f := range schemaFields {
+					searchableBy[f.Name] = f.SearchableBy
+				}
+			}
+			if err := checkFilterOperator(field, operator, searchableBy[field]); err != nil {
+				return nil, fmt.Errorf("invalid filter on '%s' objects: %w", objType, err)
+			}
+		}
+
+		value := block["value"].(string)
+		if operator == "in" {
+			values, err := filterInValues(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter on field '%s': %w", field, err)
+			}
+			value = strings.Join(values, ",")
+		}
+
+		key := searchFieldOfFilter(field, operator, isEA)
+		if prev, ok := operators[key]; ok {
+			if prev != operator {
+				return nil, fmt.Errorf("the filters on field '%s' with operators '%s' and '%s' cannot be combined, "+
+					"list all the values in a single filter with operator 'in'", field, prev, operator)
+			}
+			return nil, fmt.Errorf("the filter on field '%s' with operator '%s' is given more than once", field, operator)
+		}
+		if _, ok := res[key]; ok {
+			return nil, fmt.Errorf("the filter on field '%s' with operator '%s' is given more than once", field, operator)
+		}
+		operators[key] = operator
+		res[key] = value
+	}
+
+	return res, nil
+}
+
+// filterInValues returns the comma-separated values of a filter with the 'in' operator.
+// The request builder of the go-client sends a value with commas as the repeated query parameters
+// of the search field, so the values are only trimmed and checked here.
+func filterInValues(value string) ([]string, error) {
+	var res []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no values are given for operator 'in'")
+	}
+
+	return res, nil
+}
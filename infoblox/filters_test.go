@@ -0,0 +1,267 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// testSchemaConnector serves the WAPI schema of an object type with the given searchable fields.
+type testSchemaConnector struct {
+	ibclient.IBConnector
+
+	searchableBy map[string]string
+	requests     int
+}
+
+func (c *testSchemaConnector) GetObject(
+	obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+
+	c.requests++
+	fields := make([]map[string]interface{}, 0, len(c.searchableBy))
+	for name, modifiers := range c.searchableBy {
+		fields = append(fields, map[string]interface{}{"name": name, "searchable_by": modifiers})
+	}
+	data, err := json.Marshal(map[string]interface{}{"fields": fields})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, res)
+}
+
+func filterBlock(field, operator, value string, isEA bool) map[string]interface{} {
+	return map[string]interface{}{"field": field, "operator": operator, "value": value, "ea": isEA}
+}
+
+func TestExpandFilterBlocks(t *testing.T) {
+	conn := &testSchemaConnector{searchableBy: map[string]string{
+		"name":    "=~:",
+		"comment": ":=~",
+		"ttl":     "<=>",
+		"view":    "=",
+		"zone":    "",
+	}}
+
+	res, err := expandFilterBlocks(conn, "record:a", []interface{}{
+		filterBlock("name", "regex", "^web", false),
+		filterBlock("comment", "ieq", "Test", false),
+		filterBlock("ttl", "ge", "300", false),
+		filterBlock("Site", "ne", "HQ", true),
+		filterBlock("Owner", "", "ops", true),
+	}, map[string]string{"view": "default"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]string{
+		"view":     "default",
+		"name~":    "^web",
+		"comment:": "Test",
+		"ttl>":     "300",
+		"*Site!":   "HQ",
+		"*Owner":   "ops",
+	}
+	if len(res) != len(expected) {
+		t.Errorf("expected %v, got %v", expected, res)
+	}
+	for k, v := range expected {
+		if res[k] != v {
+			t.Errorf("expected '%s' for '%s', got '%s'", v, k, res[k])
+		}
+	}
+	if conn.requests != 1 {
+		t.Errorf("expected the schema to be read once, got %d requests", conn.requests)
+	}
+
+	errCases := []struct {
+		blocks []interface{}
+		err    string
+	}{
+		{[]interface{}{filterBlock("view", "regex", "x", false)}, "does not support 'regex' operator"},
+		{[]interface{}{filterBlock("zone", "eq", "x", false)}, "is not searchable"},
+		{[]interface{}{filterBlock("unknown", "eq", "x", false)}, "is not searchable"},
+		{[]interface{}{filterBlock("name", "lt", "x", false)}, "unsupported filter operator"},
+		{[]interface{}{filterBlock("view", "eq", "x", false)}, "more than once"},
+	}
+	for _, tc := range errCases {
+		_, err := expandFilterBlocks(conn, "record:a", tc.blocks, map[string]string{"view": "default"})
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("expected error containing '%s', got %v", tc.err, err)
+		}
+	}
+}
+
+func TestExpandFilterBlocksIn(t *testing.T) {
+	conn := &testSchemaConnector{searchableBy: map[string]string{"name": "=~:", "view": "="}}
+	sf, err := expandFilterBlocks(conn, "record:a", []interface{}{
+		filterBlock("name", "in", "a.test.com, b.test.com,", false),
+		filterBlock("Site", "in", "HQ,Branch", true),
+	}, map[string]string{"view": "default"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	requestor := &testRequestor{response: `[{"_ref": "record:a/ZG5z:a.test.com/default"}]`}
+	var res []map[string]interface{}
+	err = newTestWapiConnector(requestor).GetObject(
+		newWapiRawObject("record:a", nil), "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	query := requestor.requests[0].URL.Query()
+	for field, expected := range map[string][]string{
+		"name":  {"a.test.com", "b.test.com"},
+		"*Site": {"HQ", "Branch"},
+		"view":  {"default"},
+	} {
+		values := query[field]
+		sort.Strings(values)
+		sort.Strings(expected)
+		if strings.Join(values, ",") != strings.Join(expected, ",") {
+			t.Errorf("expected the values %v of '%s' as repeated query parameters, got %v", expected, field, query[field])
+		}
+	}
+
+	errCases := []struct {
+		blocks []interface{}
+		err    string
+	}{
+		{[]interface{}{
+			filterBlock("name", "eq", "a.test.com", false),
+			filterBlock("name", "in", "b.test.com,c.test.com", false),
+		}, "with operators 'eq' and 'in' cannot be combined"},
+		{[]interface{}{filterBlock("name", "in", " , ", false)}, "no values are given for operator 'in'"},
+	}
+	for _, tc := range errCases {
+		_, err := expandFilterBlocks(conn, "record:a", tc.blocks, nil)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("expected error containing '%s', got %v", tc.err, err)
+		}
+	}
+}
+
+func TestExpandFilterBlocksWithoutSchema(t *testing.T) {
+	conn := &testSchemaConnector{}
+	if _, err := expandFilterBlocks(conn, "record:a", []interface{}{filterBlock("Site", "regex", "^H", true)}, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if conn.requests != 0 {
+		t.Errorf("the schema must not be read for the filters on extensible attributes only")
+	}
+}
+
+func TestCheckFilterOperator(t *testing.T) {
+	cases := []struct {
+		operator     string
+		searchableBy string
+		err          string
+	}{
+		{"eq", "=", ""},
+		{"in", "=", ""},
+		{"ne", "=!", ""},
+		{"ine", ":=!", ""},
+		{"not_regex", "~!", ""},
+		{"le", "<=>", ""},
+		{"ge", "<=>", ""},
+		{"in", "~", "does not support 'in' operator"},
+		{"ne", "=", "does not support 'ne' operator"},
+		{"ine", "=!", "does not support 'ine' operator"},
+		{"eq", "", "is not searchable"},
+	}
+	for _, tc := range cases {
+		err := checkFilterOperator("name", tc.operator, tc.searchableBy)
+		if tc.err == "" && err != nil {
+			t.Errorf("operator '%s' on '%s': unexpected error: %s", tc.operator, tc.searchableBy, err)
+		}
+		if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("operator '%s' on '%s': expected error containing '%s', got %v", tc.operator, tc.searchableBy, tc.err, err)
+		}
+	}
+}
+
+func TestFilterBlocksSchema(t *testing.T) {
+	r := dataSourceNetworkView()
+	if r.Schema["filters"].Required || !r.Schema["filters"].Optional {
+		t.Errorf("'filters' must be optional along with the 'filter' blocks")
+	}
+
+	for _, tc := range []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{map[string]interface{}{"filters": map[string]interface{}{"name": "default"}}, true},
+		{map[string]interface{}{"filter": []interface{}{filterBlock("name", "eq", "default", false)}}, true},
+		{map[string]interface{}{}, false},
+		{map[string]interface{}{
+			"filters": map[string]interface{}{"name": "default"},
+			"filter":  []interface{}{filterBlock("comment", "eq", "x", false)},
+		}, false},
+	} {
+		diags := r.Validate(terraform.NewResourceConfigRaw(tc.config))
+		if diags.HasError() == tc.valid {
+			t.Errorf("config %v: expected valid=%t, got %v", tc.config, tc.valid, diags)
+		}
+	}
+}
+
+func TestAccDataSourceNetworkViewFilterBlocks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_network_view" "filtered" {
+						count = 2
+						name = "tf_acc_test_filtered_view_${count.index}"
+						comment = "Filtered view ${count.index}"
+						ext_attrs = jsonencode({
+							"Location" = "AcceptanceTerraformFilter"
+						})
+					}
+
+					data "infoblox_network_view" "regex" {
+						filter {
+							field = "name"
+							operator = "regex"
+							value = "^tf_acc_test_filtered_view_"
+						}
+						filter {
+							field = "comment"
+							operator = "ieq"
+							value = "filtered view 1"
+						}
+						filter {
+							field = "Location"
+							value = "AcceptanceTerraformFilter"
+							ea = true
+						}
+						depends_on = [infoblox_network_view.filtered]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_network_view.regex", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_network_view.regex", "results.0.name", "tf_acc_test_filtered_view_1"),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_network_view" "invalid" {
+						filter {
+							field = "name"
+							operator = "ge"
+							value = "a"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("does not support 'ge' operator"),
+			},
+		},
+	})
+}
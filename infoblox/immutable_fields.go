@@ -0,0 +1,64 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// immutableFieldsDiff is the part of schema.ResourceDiff used to check the changes of the immutable fields.
+type immutableFieldsDiff interface {
+	Id() string
+	HasChange(key string) bool
+	ForceNew(key string) error
+}
+
+// withImmutableFields makes the changes of the fields, which cannot be updated for an existing object,
+// be detected at the planning stage instead of failing the update: the resource is planned for replacement
+// if 'replace_on_immutable_change' is enabled at the provider level, otherwise the plan fails.
+func withImmutableFields(r *schema.Resource, fields ...string) *schema.Resource {
+	return withImmutableFieldsUnlessChanged(r, "", fields...)
+}
+
+// withImmutableFieldsUnlessChanged is the same as withImmutableFields, except that the fields may be changed
+// together with the 'trigger' field, like the DNS view of a host record when DNS is enabled or disabled for it.
+func withImmutableFieldsUnlessChanged(r *schema.Resource, trigger string, fields ...string) *schema.Resource {
+	customizeDiffImmutableFields := func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if trigger != "" && d.HasChange(trigger) {
+			return nil
+		}
+		return checkImmutableFields(d, fields, replaceOnImmutableChangeFromMeta(m))
+	}
+
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.All(r.CustomizeDiff, customizeDiffImmutableFields)
+	} else {
+		r.CustomizeDiff = customizeDiffImmutableFields
+	}
+
+	return r
+}
+
+func checkImmutableFields(d immutableFieldsDiff, fields []string, replace bool) error {
+	// The fields of a new resource are not changed but set.
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, f := range fields {
+		if !d.HasChange(f) {
+			continue
+		}
+		if !replace {
+			return fmt.Errorf(
+				"changing the value of '%s' field is not allowed; enable 'replace_on_immutable_change'"+
+					" in the provider configuration to replace the resource instead", f)
+		}
+		if err := d.ForceNew(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type testImmutableFieldsDiff struct {
+	id       string
+	changed  map[string]bool
+	forceNew []string
+}
+
+func (d *testImmutableFieldsDiff) Id() string {
+	return d.id
+}
+
+func (d *testImmutableFieldsDiff) HasChange(key string) bool {
+	return d.changed[key]
+}
+
+func (d *testImmutableFieldsDiff) ForceNew(key string) error {
+	if !d.changed[key] {
+		return fmt.Errorf("ForceNew: no changes for %s", key)
+	}
+	d.forceNew = append(d.forceNew, key)
+
+	return nil
+}
+
+func TestCheckImmutableFields(t *testing.T) {
+	fields := []string{"network_view", "dns_view", "filter_params"}
+	changed := map[string]bool{"dns_view": true, "filter_params": true, "comment": true}
+
+	d := &testImmutableFieldsDiff{changed: changed}
+	if err := checkImmutableFields(d, fields, false); err != nil || len(d.forceNew) != 0 {
+		t.Errorf("expected no checks for a new resource, got error %v, replacement by %v", err, d.forceNew)
+	}
+
+	d = &testImmutableFieldsDiff{id: "record:a/ZG5z:test.com/default", changed: changed}
+	err := checkImmutableFields(d, fields, false)
+	if err == nil || !regexp.MustCompile("changing the value of 'dns_view' field is not allowed").MatchString(err.Error()) {
+		t.Errorf("expected the change of 'dns_view' to fail, got %v", err)
+	}
+
+	d = &testImmutableFieldsDiff{id: "record:a/ZG5z:test.com/default", changed: changed}
+	if err = checkImmutableFields(d, fields, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fmt.Sprint(d.forceNew) != "[dns_view filter_params]" {
+		t.Errorf("expected the replacement by 'dns_view' and 'filter_params', got %v", d.forceNew)
+	}
+
+	d = &testImmutableFieldsDiff{id: "record:a/ZG5z:test.com/default", changed: map[string]bool{"comment": true}}
+	if err = checkImmutableFields(d, fields, false); err != nil || len(d.forceNew) != 0 {
+		t.Errorf("expected the update to be allowed, got error %v, replacement by %v", err, d.forceNew)
+	}
+}
+
+func TestWithImmutableFieldsUnlessChanged(t *testing.T) {
+	r := withImmutableFieldsUnlessChanged(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enable_dns": {Type: schema.TypeBool, Optional: true},
+			"dns_view":   {Type: schema.TypeString, Optional: true},
+		},
+	}, "enable_dns", "dns_view")
+	state := &terraform.InstanceState{
+		ID:         "record:host/ZG5z:test.com/default",
+		Attributes: map[string]string{"id": "record:host/ZG5z:test.com/default", "enable_dns": "true", "dns_view": "default"},
+	}
+
+	cfg := terraform.NewResourceConfigRaw(map[string]interface{}{"enable_dns": true, "dns_view": "external"})
+	_, err := r.Diff(context.Background(), state, cfg, &providerMeta{})
+	if err == nil || !regexp.MustCompile("changing the value of 'dns_view' field is not allowed").MatchString(err.Error()) {
+		t.Errorf("expected the change of 'dns_view' to fail, got %v", err)
+	}
+
+	cfg = terraform.NewResourceConfigRaw(map[string]interface{}{"enable_dns": false, "dns_view": "external"})
+	if _, err = r.Diff(context.Background(), state, cfg, &providerMeta{}); err != nil {
+		t.Errorf("expected the change of 'dns_view' together with 'enable_dns' to be allowed, got %v", err)
+	}
+}
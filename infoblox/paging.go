@@ -0,0 +1,175 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+const (
+	// defaultPageSize is the default number of objects requested from NIOS at once.
+	defaultPageSize = 1000
+	// maxPageSize is the maximum number of objects NIOS returns in a single page.
+	maxPageSize = 1000
+)
+
+// wapiPage is the response of NIOS to a request for a page of objects.
+type wapiPage struct {
+	Result     json.RawMessage `json:"result"`
+	NextPageID string          `json:"next_page_id"`
+}
+
+// wapiPager fetches the NIOS objects matching the search fields page by page,
+// using WAPI's server-side paging.
+type wapiPager struct {
+	conn         ibclient.IBConnector
+	obj          ibclient.IBObject
+	searchFields map[string]string
+	pageSize     int
+
+	nextPageID string
+	done       bool
+}
+
+func newWapiPager(conn ibclient.IBConnector, obj ibclient.IBObject, searchFields map[string]string, pageSize int) *wapiPager {
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = defaultPageSize
+	}
+
+	return &wapiPager{conn: conn, obj: obj, searchFields: searchFields, pageSize: pageSize}
+}
+
+// next fetches the next page of objects into res, which must be a pointer to a slice.
+// Fetching a page after the last one returns no objects.
+func (p *wapiPager) next(res interface{}) error {
+	if p.done {
+		return nil
+	}
+
+	params := make(map[string]string, len(p.searchFields)+4)
+	for k, v := range p.searchFields {
+		params[k] = v
+	}
+	params["_paging"] = "1"
+	params["_return_as_object"] = "1"
+	params["_max_results"] = fmt.Sprintf("%d", p.pageSize)
+	if p.nextPageID != "" {
+		params["_page_id"] = p.nextPageID
+	}
+
+	var page wapiPage
+	if err := p.conn.GetObject(p.obj, "", ibclient.NewQueryParams(false, params), &page); err != nil {
+		if isNotFoundError(err) {
+			p.done = true
+			return nil
+		}
+		return err
+	}
+	if len(page.Result) > 0 {
+		if err := json.Unmarshal(page.Result, res); err != nil {
+			return fmt.Errorf("failed to parse the page of '%s' objects: %w", p.obj.ObjectType(), err)
+		}
+	}
+	p.nextPageID = page.NextPageID
+	p.done = page.NextPageID == ""
+
+	return nil
+}
+
+// hasNext checks whether there may be more pages to fetch.
+func (p *wapiPager) hasNext() bool {
+	return !p.done
+}
+
+// withPaging adds the 'page_size' and 'max_results' arguments and the 'truncated' attribute
+// to the schema of a data source; objectsName names the objects in the descriptions.
+func withPaging(r *schema.Resource, objectsName string) *schema.Resource {
+	r.Schema["page_size"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      defaultPageSize,
+		ValidateFunc: validation.IntBetween(1, maxPageSize),
+		Description:  fmt.Sprintf("The number of %s fetched from NIOS at once.", objectsName),
+	}
+	r.Schema["max_results"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  fmt.Sprintf("The maximum number of %s to return; zero means no limit.", objectsName),
+	}
+	r.Schema["truncated"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: fmt.Sprintf("Indicates whether there are more matching %s than returned, due to 'max_results'.", objectsName),
+	}
+
+	return r
+}
+
+// fetchPagedObjects fetches at most maxResults objects matching the search fields, or all of them
+// if maxResults is zero, and reports whether there are more matching objects than returned.
+func fetchPagedObjects[T any](
+	conn ibclient.IBConnector, obj ibclient.IBObject, searchFields map[string]string, pageSize, maxResults int) ([]T, bool, error) {
+
+	pager := newWapiPager(conn, obj, searchFields, pageSize)
+	res := make([]T, 0)
+	for pager.hasNext() {
+		var page []T
+		if err := pager.next(&page); err != nil {
+			return nil, false, err
+		}
+		for _, o := range page {
+			if maxResults > 0 && len(res) == maxResults {
+				return res, true, nil
+			}
+			res = append(res, o)
+		}
+	}
+
+	return res, false, nil
+}
+
+// getPagedObjects fetches the objects matching the search fields and the 'filter' blocks of the data source,
+// according to its paging arguments, and sets its 'truncated' attribute.
+func getPagedObjects[T any](
+	d *schema.ResourceData, conn ibclient.IBConnector, obj ibclient.IBObject, searchFields map[string]string) ([]T, error) {
+
+	return getSortedPagedObjects[T](d, conn, obj, searchFields, nil)
+}
+
+// getSortedPagedObjects is the same as getPagedObjects, but sorts the objects with sortObjects, unless it is nil.
+// The objects are sorted on the client side, so all the matching objects are fetched and 'max_results'
+// is applied after sorting.
+func getSortedPagedObjects[T any](
+	d *schema.ResourceData, conn ibclient.IBConnector, obj ibclient.IBObject, searchFields map[string]string,
+	sortObjects func([]T)) ([]T, error) {
+
+	searchFields, err := expandFilterBlocks(conn, obj.ObjectType(), d.Get("filter").([]interface{}), searchFields)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults := d.Get("max_results").(int)
+	fetchLimit := maxResults
+	if sortObjects != nil {
+		fetchLimit = 0
+	}
+	res, truncated, err := fetchPagedObjects[T](conn, obj, searchFields, d.Get("page_size").(int), fetchLimit)
+	if err != nil {
+		return nil, err
+	}
+	if sortObjects != nil {
+		sortObjects(res)
+		if truncated = maxResults > 0 && len(res) > maxResults; truncated {
+			res = res[:maxResults]
+		}
+	}
+	if err = d.Set("truncated", truncated); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
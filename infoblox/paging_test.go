@@ -0,0 +1,204 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// testPagingConnector serves the pages of a fixed list of objects.
+type testPagingConnector struct {
+	ibclient.IBConnector
+
+	objects  []string
+	requests []map[string]string
+}
+
+func (c *testPagingConnector) GetObject(
+	obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+
+	wrb := &ibclient.WapiRequestBuilder{}
+	wrb.Init(ibclient.HostConfig{Host: "localhost", Version: "2.12.3"}, ibclient.AuthConfig{})
+	u, err := url.Parse(wrb.BuildUrl(ibclient.GET, obj.ObjectType(), ref, obj.ReturnFields(), qp))
+	if err != nil {
+		return err
+	}
+	params := make(map[string]string)
+	for k := range u.Query() {
+		params[k] = u.Query().Get(k)
+	}
+	c.requests = append(c.requests, params)
+
+	var start, size int
+	if params["_page_id"] != "" {
+		if _, err := fmt.Sscanf(params["_page_id"], "page-%d", &start); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Sscanf(params["_max_results"], "%d", &size); err != nil {
+		return err
+	}
+
+	end := start + size
+	page := map[string]interface{}{}
+	if end < len(c.objects) {
+		page["next_page_id"] = fmt.Sprintf("page-%d", end)
+	} else {
+		end = len(c.objects)
+	}
+	result := make([]map[string]string, 0, end-start)
+	for _, name := range c.objects[start:end] {
+		result = append(result, map[string]string{"name": name})
+	}
+	page["result"] = result
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, res)
+}
+
+func TestWapiPager(t *testing.T) {
+	conn := &testPagingConnector{objects: []string{"a", "b", "c", "d", "e"}}
+	pager := newWapiPager(conn, newWapiRawObject("namedacl", nil), map[string]string{"name": "x"}, 2)
+
+	var names []string
+	for pager.hasNext() {
+		var page []struct {
+			Name string `json:"name"`
+		}
+		if err := pager.next(&page); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		for _, o := range page {
+			names = append(names, o.Name)
+		}
+	}
+
+	if fmt.Sprint(names) != "[a b c d e]" {
+		t.Errorf("expected all the objects to be fetched, got %v", names)
+	}
+	if len(conn.requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(conn.requests))
+	}
+	for i, r := range conn.requests {
+		if r["_paging"] != "1" || r["_return_as_object"] != "1" || r["_max_results"] != "2" || r["name"] != "x" {
+			t.Errorf("unexpected parameters of request %d: %v", i, r)
+		}
+	}
+	if conn.requests[0]["_page_id"] != "" || conn.requests[2]["_page_id"] != "page-4" {
+		t.Errorf("unexpected page IDs: %v", conn.requests)
+	}
+}
+
+// testNotFoundConnector fails all the requests with the not found error, as NIOS does for some object types,
+// when no object matches the search fields.
+type testNotFoundConnector struct {
+	ibclient.IBConnector
+}
+
+func (c *testNotFoundConnector) GetObject(
+	obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+
+	return ibclient.NewNotFoundError("not found")
+}
+
+func TestWapiPagerNotFound(t *testing.T) {
+	pager := newWapiPager(&testNotFoundConnector{}, newWapiRawObject("namedacl", nil), nil, 2)
+
+	var page []map[string]interface{}
+	if err := pager.next(&page); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if len(page) != 0 || pager.hasNext() {
+		t.Errorf("expected no objects and no more pages, got %v, %t", page, pager.hasNext())
+	}
+}
+
+func TestWapiPagerPageSize(t *testing.T) {
+	for _, size := range []int{0, -1, maxPageSize + 1} {
+		if p := newWapiPager(nil, nil, nil, size); p.pageSize != defaultPageSize {
+			t.Errorf("expected the default page size for %d, got %d", size, p.pageSize)
+		}
+	}
+}
+
+func TestFetchPagedObjects(t *testing.T) {
+	type namedObject struct {
+		Name string `json:"name"`
+	}
+	cases := []struct {
+		maxResults int
+		names      string
+		truncated  bool
+		requests   int
+	}{
+		{0, "[{a} {b} {c} {d} {e}]", false, 3},
+		{3, "[{a} {b} {c}]", true, 2},
+		{4, "[{a} {b} {c} {d}]", true, 3},
+		{5, "[{a} {b} {c} {d} {e}]", false, 3},
+	}
+	for _, tc := range cases {
+		conn := &testPagingConnector{objects: []string{"a", "b", "c", "d", "e"}}
+		res, truncated, err := fetchPagedObjects[namedObject](conn, newWapiRawObject("namedacl", nil), nil, 2, tc.maxResults)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if fmt.Sprint(res) != tc.names || truncated != tc.truncated {
+			t.Errorf("max results %d: expected %s, truncated %t; got %v, truncated %t",
+				tc.maxResults, tc.names, tc.truncated, res, truncated)
+		}
+		if len(conn.requests) != tc.requests {
+			t.Errorf("max results %d: expected %d requests, got %d", tc.maxResults, tc.requests, len(conn.requests))
+		}
+	}
+}
+
+func TestAccDataSourceNetworkViewPaging(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_network_view" "paged" {
+						count = 3
+						name = "tf_acc_test_paged_view_${count.index}"
+						ext_attrs = jsonencode({
+							"Location" = "AcceptanceTerraformPaging"
+						})
+					}
+
+					data "infoblox_network_view" "all" {
+						filters = {
+							"*Location" = "AcceptanceTerraformPaging"
+						}
+						page_size = 1
+						depends_on = [infoblox_network_view.paged]
+					}
+
+					data "infoblox_network_view" "limited" {
+						filters = {
+							"*Location" = "AcceptanceTerraformPaging"
+						}
+						page_size = 1
+						max_results = 2
+						depends_on = [infoblox_network_view.paged]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_network_view.all", "results.#", "3"),
+					resource.TestCheckResourceAttr("data.infoblox_network_view.all", "truncated", "false"),
+					resource.TestCheckResourceAttr("data.infoblox_network_view.limited", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_network_view.limited", "truncated", "true"),
+				),
+			},
+		},
+	})
+}
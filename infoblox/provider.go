@@ -1,10 +1,13 @@
 package infoblox
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 	log "github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -132,6 +135,58 @@ func checkAndTrimSpaces(text string) (string, bool) {
 
 const errMsgFormatLeadingTrailingSpaces = "leading or trailing spaces are not allowed for the '%s' field"
 
+// providerMeta is the provider's meta value, which is passed to every resource and data source.
+// It embeds the connector, so it may be used as ibclient.IBConnector,
+// and keeps the settings which are common for all the resources.
+type providerMeta struct {
+	ibclient.IBConnector
+
+	defaultEAs               map[string]interface{}
+	restarter                *serviceRestarter
+	replaceOnImmutableChange bool
+	adoptExisting            bool
+
+	// The connector of the provider, used to call WAPI functions and to bind the requests
+	// to the contexts of the operations.
+	connector *wapiConnector
+}
+
+// callFunction sends a POST request with the '_function' argument, which the go-client's connector does not support.
+func (meta *providerMeta) callFunction(ref, function string, args map[string]interface{}, res interface{}) error {
+	if meta.connector == nil {
+		return fmt.Errorf("the connector does not support calls of WAPI functions")
+	}
+
+	return meta.connector.callFunction(ref, function, args, res)
+}
+
+// defaultEAsFromMeta returns the default extensible attributes specified at the provider level.
+func defaultEAsFromMeta(m interface{}) map[string]interface{} {
+	if meta, ok := m.(*providerMeta); ok {
+		return meta.defaultEAs
+	}
+
+	return nil
+}
+
+// replaceOnImmutableChangeFromMeta returns whether the changes of the immutable fields plan replacements.
+func replaceOnImmutableChangeFromMeta(m interface{}) bool {
+	if meta, ok := m.(*providerMeta); ok {
+		return meta.replaceOnImmutableChange
+	}
+
+	return false
+}
+
+// adoptExistingFromMeta returns whether the resources take the conflicting existing objects under management.
+func adoptExistingFromMeta(m interface{}) bool {
+	if meta, ok := m.(*providerMeta); ok {
+		return meta.adoptExisting
+	}
+
+	return false
+}
+
 func isNotFoundError(err error) bool {
 	if _, notFoundErr := err.(*ibclient.NotFoundError); notFoundErr {
 		return true
@@ -140,8 +195,152 @@ func isNotFoundError(err error) bool {
 	return false
 }
 
+// expandStringList converts a list of strings taken from the resource's state.
+func expandStringList(list []interface{}) []string {
+	res := make([]string, 0, len(list))
+	for _, v := range list {
+		if str, ok := v.(string); ok {
+			res = append(res, str)
+		}
+	}
+
+	return res
+}
+
+// getWriteOnlyString returns the configured value of a write-only string field,
+// which is never present in the plan or the state, so it is not available through d.Get().
+func getWriteOnlyString(d *schema.ResourceData, key string) (string, error) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() {
+		return "", fmt.Errorf("failed to read '%s' field from the configuration", key)
+	}
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", nil
+	}
+
+	return value.AsString(), nil
+}
+
+// diagnosticsError returns the errors among the diagnostics as a single error,
+// for the functions which return errors, like importers, to call the context-aware operations.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail != "" {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		} else {
+			errs = append(errs, errors.New(d.Summary))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// computedSchema returns a copy of a resource's field schema, suitable for the results of data sources:
+// the field and all its nested fields are computed.
+func computedSchema(s *schema.Schema) *schema.Schema {
+	res := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Sensitive:   s.Sensitive,
+		Description: s.Description,
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		elemSchema := make(map[string]*schema.Schema, len(elem.Schema))
+		for name, field := range elem.Schema {
+			elemSchema[name] = computedSchema(field)
+		}
+		res.Elem = &schema.Resource{Schema: elemSchema}
+	case *schema.Schema:
+		res.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return res
+}
+
+// wapiRawObject is a NIOS object of the given type with an arbitrary set of fields.
+// It is used instead of the structs of the go-client, when they are not able to express
+// a value to be sent to NIOS, for example an empty list, which is dropped due to 'omitempty'.
+type wapiRawObject struct {
+	ibclient.IBBase
+	objectType string
+	fields     map[string]interface{}
+}
+
+func newWapiRawObject(objectType string, fields map[string]interface{}) *wapiRawObject {
+	return &wapiRawObject{objectType: objectType, fields: fields}
+}
+
+func (obj *wapiRawObject) ObjectType() string {
+	return obj.objectType
+}
+
+func (obj *wapiRawObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(obj.fields)
+}
+
+// newWapiRawObjectFrom converts the go-client's object into wapiRawObject of the given type
+// with the same fields and return fields, so that the fields which the go-client's structs
+// are not able to express may be added to the same request.
+func newWapiRawObjectFrom(objectType string, obj ibclient.IBObject) (*wapiRawObject, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+
+	rawObj := newWapiRawObject(objectType, fields)
+	rawObj.SetReturnFields(obj.ReturnFields())
+
+	return rawObj, nil
+}
+
+// withWapiFields returns the object with the given fields added to it.
+// The object itself is returned, if there are no fields to add.
+func withWapiFields(obj ibclient.IBObject, fields map[string]interface{}) (ibclient.IBObject, error) {
+	if len(fields) == 0 {
+		return obj, nil
+	}
+	rawObj, err := newWapiRawObjectFrom(obj.ObjectType(), obj)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range fields {
+		rawObj.fields[name] = value
+	}
+
+	return rawObj, nil
+}
+
+// wapiFunctionCaller calls functions of NIOS objects, which are not supported by the go-client's connector.
+type wapiFunctionCaller interface {
+	callFunction(ref, function string, args map[string]interface{}, res interface{}) error
+}
+
+// callWapiFunction calls a function of the NIOS object with the given reference,
+// and unmarshals the result of the function into res, unless it is nil.
+func callWapiFunction(
+	conn ibclient.IBConnector, ref, function string, args map[string]interface{}, res interface{}) error {
+
+	caller, ok := conn.(wapiFunctionCaller)
+	if !ok {
+		return fmt.Errorf("the connector does not support calls of WAPI functions")
+	}
+
+	return caller.callFunction(ref, function, args, res)
+}
+
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"server": {
 				Type:        schema.TypeString,
@@ -192,67 +391,127 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("POOL_CONNECTIONS", "10"),
 				Description: "Maximum number of connections to establish to the Infoblox server. Zero means unlimited.",
 			},
+			"default_ext_attrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     defaultExtensibleAttributesSchema(),
+				Description: "Extensible attributes to be attached to every object created or updated by the provider," +
+					" unless the same extensible attribute is specified for the resource.",
+			},
+			"restart_services": restartServicesSchema(),
+			"replace_on_immutable_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, a change of a field, which cannot be updated for an existing object," +
+					" plans a replacement of the resource; otherwise such a change fails the plan.",
+			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, a resource, which cannot be created because the same object exists already," +
+					" takes the existing object under management, unless the object is managed by another resource.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"infoblox_network_view":           resourceNetworkView(),
 			"infoblox_ipv4_network_container": resourceIPv4NetworkContainer(),
 			"infoblox_ipv6_network_container": resourceIPv6NetworkContainer(),
-			"infoblox_ipv4_network":           resourceIPv4Network(),
-			"infoblox_ipv6_network":           resourceIPv6Network(),
-			"infoblox_ip_allocation":          resourceIPAllocation(),
-			"infoblox_ip_association":         resourceIpAssociationInit(),
-			"infoblox_a_record":               resourceARecord(),
-			"infoblox_aaaa_record":            resourceAAAARecord(),
-			"infoblox_cname_record":           resourceCNAMERecord(),
-			"infoblox_ptr_record":             resourcePTRRecord(),
-			"infoblox_zone_delegated":         resourceZoneDelegated(),
-			"infoblox_txt_record":             resourceTXTRecord(),
-			"infoblox_mx_record":              resourceMXRecord(),
-			"infoblox_srv_record":             resourceSRVRecord(),
-			"infoblox_dns_view":               resourceDNSView(),
-			"infoblox_zone_auth":              resourceZoneAuth(),
-			"infoblox_zone_forward":           resourceZoneForward(),
-			"infoblox_dtc_lbdn":               resourceDtcLbdnRecord(),
-			"infoblox_dtc_pool":               resourceDtcPool(),
-			"infoblox_dtc_server":             resourceDtcServer(),
-			"infoblox_ipv4_fixed_address":     resourceFixedRecord(),
-			"infoblox_alias_record":           resourceAliasRecord(),
-			"infoblox_ns_record":              resourceNSRecord(),
-			"infoblox_ipv4_range":             resourceRange(),
+			"infoblox_ipv4_network":           withServiceRestart(resourceIPv4Network(), restartServiceDHCP),
+			"infoblox_ipv6_network":           withServiceRestart(resourceIPv6Network(), restartServiceDHCP),
+			"infoblox_ip_allocation":          withServiceRestart(resourceIPAllocation(), restartServiceDNS, restartServiceDHCP),
+			"infoblox_ip_association":         withServiceRestart(resourceIpAssociationInit(), restartServiceDNS, restartServiceDHCP),
+			"infoblox_a_record":               withServiceRestart(resourceARecord(), restartServiceDNS),
+			"infoblox_aaaa_record":            withServiceRestart(resourceAAAARecord(), restartServiceDNS),
+			"infoblox_cname_record":           withServiceRestart(resourceCNAMERecord(), restartServiceDNS),
+			"infoblox_ptr_record":             withServiceRestart(resourcePTRRecord(), restartServiceDNS),
+			"infoblox_zone_delegated":         withServiceRestart(resourceZoneDelegated(), restartServiceDNS),
+			"infoblox_txt_record":             withServiceRestart(resourceTXTRecord(), restartServiceDNS),
+			"infoblox_mx_record":              withServiceRestart(resourceMXRecord(), restartServiceDNS),
+			"infoblox_srv_record":             withServiceRestart(resourceSRVRecord(), restartServiceDNS),
+			"infoblox_dns_view":               withServiceRestart(resourceDNSView(), restartServiceDNS),
+			"infoblox_zone_auth":              withServiceRestart(resourceZoneAuth(), restartServiceDNS),
+			"infoblox_zone_forward":           withServiceRestart(resourceZoneForward(), restartServiceDNS),
+			"infoblox_dtc_lbdn":               withServiceRestart(resourceDtcLbdnRecord(), restartServiceDNS),
+			"infoblox_dtc_pool":               withServiceRestart(resourceDtcPool(), restartServiceDNS),
+			"infoblox_dtc_server":             withServiceRestart(resourceDtcServer(), restartServiceDNS),
+			"infoblox_ipv4_fixed_address":     withServiceRestart(resourceFixedRecord(), restartServiceDHCP),
+			"infoblox_alias_record":           withServiceRestart(resourceAliasRecord(), restartServiceDNS),
+			"infoblox_ns_record":              withServiceRestart(resourceNSRecord(), restartServiceDNS),
+			"infoblox_ipv4_range":             withServiceRestart(resourceRange(), restartServiceDHCP),
 			"infoblox_ipv4_range_template":    resourceRangeTemplate(),
-			"infoblox_ipv4_shared_network":    resourceIpv4SharedNetwork(),
+			"infoblox_ipv4_shared_network":    withServiceRestart(resourceIpv4SharedNetwork(), restartServiceDHCP),
+			"infoblox_admin_group":            resourceAdminGroup(),
+			"infoblox_admin_user":             resourceAdminUser(),
+			"infoblox_admin_role":             resourceAdminRole(),
+			"infoblox_permission":             resourcePermission(),
+			"infoblox_named_acl":              withServiceRestart(resourceNamedACL(), restartServiceDNS),
+			"infoblox_member_dns_views":       withServiceRestart(resourceMemberDNSViews(), restartServiceDNS),
+			"infoblox_member_service":         resourceMemberService(),
+			"infoblox_vlan_view":              resourceVlanView(),
+			"infoblox_vlan_range":             resourceVlanRange(),
+			"infoblox_vlan":                   resourceVlan(),
+			"infoblox_ms_server":              resourceMsServer(),
+			"infoblox_wapi_object":            resourceWapiObject(),
+			"infoblox_grid_service_restart":   resourceGridServiceRestart(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"infoblox_ipv4_network":           dataSourceIPv4Network(),
-			"infoblox_ipv6_network":           dataSourceIPv6Network(),
-			"infoblox_ipv4_network_container": dataSourceIpv4NetworkContainer(),
-			"infoblox_ipv6_network_container": dataSourceIpv6NetworkContainer(),
-			"infoblox_network_view":           dataSourceNetworkView(),
-			"infoblox_a_record":               dataSourceARecord(),
-			"infoblox_aaaa_record":            dataSourceAAAARecord(),
-			"infoblox_cname_record":           dataSourceCNameRecord(),
-			"infoblox_ptr_record":             dataSourcePtrRecord(),
-			"infoblox_zone_delegated":         dataSourceZoneDelegated(),
-			"infoblox_txt_record":             dataSourceTXTRecord(),
-			"infoblox_mx_record":              dataSourceMXRecord(),
-			"infoblox_srv_record":             dataSourceSRVRecord(),
-			"infoblox_host_record":            dataSourceHostRecord(),
-			"infoblox_zone_auth":              dataSourceZoneAuth(),
-			"infoblox_dns_view":               dataSourceDNSView(),
-			"infoblox_zone_forward":           dataSourceZoneForward(),
-			"infoblox_dtc_lbdn":               dataSourceDtcLbdnRecord(),
-			"infoblox_dtc_pool":               datasourceDtcPool(),
-			"infoblox_dtc_server":             dataSourceDtcServer(),
-			"infoblox_ipv4_fixed_address":     dataSourceFixedAddress(),
-			"infoblox_alias_record":           dataSourceAliasRecord(),
-			"infoblox_ns_record":              dataSourceNSRecord(),
-			"infoblox_ipv4_range":             dataSourceRange(),
-			"infoblox_ipv4_range_template":    dataSourceRangeTemplate(),
-			"infoblox_ipv4_shared_network":    dataSourceIpv4SharedNetwork(),
+			"infoblox_ipv4_network":                dataSourceIPv4Network(),
+			"infoblox_ipv6_network":                dataSourceIPv6Network(),
+			"infoblox_ipv4_network_container":      dataSourceIpv4NetworkContainer(),
+			"infoblox_ipv6_network_container":      dataSourceIpv6NetworkContainer(),
+			"infoblox_network_view":                dataSourceNetworkView(),
+			"infoblox_a_record":                    dataSourceARecord(),
+			"infoblox_aaaa_record":                 dataSourceAAAARecord(),
+			"infoblox_cname_record":                dataSourceCNameRecord(),
+			"infoblox_ptr_record":                  dataSourcePtrRecord(),
+			"infoblox_zone_delegated":              dataSourceZoneDelegated(),
+			"infoblox_txt_record":                  dataSourceTXTRecord(),
+			"infoblox_mx_record":                   dataSourceMXRecord(),
+			"infoblox_srv_record":                  dataSourceSRVRecord(),
+			"infoblox_host_record":                 dataSourceHostRecord(),
+			"infoblox_zone_auth":                   dataSourceZoneAuth(),
+			"infoblox_zone_records":                dataSourceZoneRecords(),
+			"infoblox_dns_view":                    dataSourceDNSView(),
+			"infoblox_zone_forward":                dataSourceZoneForward(),
+			"infoblox_dtc_lbdn":                    dataSourceDtcLbdnRecord(),
+			"infoblox_dtc_pool":                    datasourceDtcPool(),
+			"infoblox_dtc_server":                  dataSourceDtcServer(),
+			"infoblox_ipv4_fixed_address":          dataSourceFixedAddress(),
+			"infoblox_alias_record":                dataSourceAliasRecord(),
+			"infoblox_ns_record":                   dataSourceNSRecord(),
+			"infoblox_ipv4_range":                  dataSourceRange(),
+			"infoblox_ipv4_range_template":         dataSourceRangeTemplate(),
+			"infoblox_ipv4_shared_network":         dataSourceIpv4SharedNetwork(),
+			"infoblox_admin_group":                 dataSourceAdminGroup(),
+			"infoblox_admin_user":                  dataSourceAdminUser(),
+			"infoblox_admin_role":                  dataSourceAdminRole(),
+			"infoblox_permission":                  dataSourcePermission(),
+			"infoblox_grid_service_restart_status": dataSourceGridServiceRestartStatus(),
+			"infoblox_dhcp_leases":                 dataSourceDHCPLeases(),
+			"infoblox_ipv4_address":                dataSourceIPv4Address(),
+			"infoblox_ipv6_address":                dataSourceIPv6Address(),
+			"infoblox_next_available_ip":           dataSourceNextAvailableIP(),
+			"infoblox_next_available_network":      dataSourceNextAvailableNetwork(),
+			"infoblox_network_utilization":         dataSourceNetworkUtilization(),
+			"infoblox_grid_capacity_report":        dataSourceGridCapacityReport(),
+			"infoblox_search":                      dataSourceSearch(),
+			"infoblox_ms_server":                   dataSourceMsServer(),
+			"infoblox_wapi_query":                  dataSourceWapiQuery(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for _, r := range p.ResourcesMap {
+		withTimeouts(withRequestContext(r))
+	}
+	for _, r := range p.DataSourcesMap {
+		withRequestContext(r)
+	}
+
+	return p
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -283,20 +542,30 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	}
 
 	requestBuilder := &ibclient.WapiRequestBuilder{}
-	requestor := &ibclient.WapiHttpRequestor{}
+	requestor := &wapiHttpRequestor{}
 
-	// TODO: reconsider. For the case when there is a need to keep more data than just a go-client's Connector.
-	conn, err := ibclient.NewConnector(hostConfig, authConfig, transportConfig, requestBuilder, requestor)
+	defaultEAs, err := expandExtensibleAttributes(d.Get("default_ext_attrs").(*schema.Set).List())
 	if err != nil {
-		return nil, diag.Diagnostics{diag.Diagnostic{Summary: err.Error()}}
+		return nil, diag.Diagnostics{diag.Diagnostic{Summary: fmt.Sprintf("invalid 'default_ext_attrs': %s", err)}}
 	}
 
+	conn := newWapiConnector(hostConfig, authConfig, transportConfig, requestBuilder, requestor)
+
 	// Check and Create Pre-requisites
 	err = checkAndCreatePreRequisites(conn)
 	if err != nil {
 		return nil, diag.Diagnostics{diag.Diagnostic{Summary: err.Error()}}
 	}
-	return conn, nil
+	meta := &providerMeta{
+		IBConnector:              conn,
+		defaultEAs:               defaultEAs,
+		replaceOnImmutableChange: d.Get("replace_on_immutable_change").(bool),
+		adoptExisting:            d.Get("adopt_existing").(bool),
+		restarter:                newServiceRestarter(d.Get("restart_services").([]interface{})),
+		connector:                conn,
+	}
+
+	return meta, nil
 }
 
 // filterFromMap generates filter map for NIOS query parameters from a terraform map[string]interface{}
@@ -341,10 +610,11 @@ func terraformDeserializeEAs(extAttrJSON string) (map[string]interface{}, error)
 	return extAttrs, nil
 }
 
-// omitEAs will omit NIOS-side EAs that are not present on the terraform-provider side.
+// omitEAs will omit NIOS-side EAs that are not present on the terraform-provider side,
+// including the EAs inherited from parent objects; the inherited EAs specified
+// for the resource are handled by terraformSetInheritableEAs.
 // Should be used for read operations.
 func omitEAs(niosEAs, terraformEAs map[string]interface{}) map[string]interface{} {
-	// ToDo: When EA inheritance is implemented on the go-client side, only inherited EAs should be omitted here.
 	res := niosEAs
 	for attrName, _ := range niosEAs {
 		if _, ok := terraformEAs[attrName]; !ok {
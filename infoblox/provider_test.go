@@ -1,11 +1,15 @@
 package infoblox
 
 import (
+	"encoding/json"
+	"io"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -79,3 +83,71 @@ func runTestCases(t *testing.T, cases []testCase) {
 		}
 	}
 }
+
+// testRequestor records the requests and returns a fixed response.
+type testRequestor struct {
+	ibclient.HttpRequestor
+
+	requests []*http.Request
+	bodies   []string
+	response string
+	err      error
+}
+
+func (r *testRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, string(body))
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return []byte(r.response), nil
+}
+
+func (r *testRequestor) Init(ibclient.AuthConfig, ibclient.TransportConfig) {}
+
+// newTestWapiConnector returns the connector of the provider, which sends the requests using the requestor.
+func newTestWapiConnector(requestor ibclient.HttpRequestor) *wapiConnector {
+	return newWapiConnector(
+		ibclient.HostConfig{Host: "nios.example.com", Port: "443", Version: "2.12.3"}, ibclient.AuthConfig{},
+		ibclient.TransportConfig{}, &ibclient.WapiRequestBuilder{}, requestor)
+}
+
+func TestProviderMetaCallFunction(t *testing.T) {
+	requestor := &testRequestor{response: `{"ips": ["10.0.0.5", "10.0.0.6"]}`}
+	meta := &providerMeta{connector: newTestWapiConnector(requestor)}
+
+	var res struct {
+		IPs []string `json:"ips"`
+	}
+	err := callWapiFunction(meta, "network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default",
+		"next_available_ip", map[string]interface{}{"num": 2}, &res)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(requestor.requests) != 1 {
+		t.Fatalf("expected a single request, got %d", len(requestor.requests))
+	}
+	req := requestor.requests[0]
+	if req.Method != http.MethodPost {
+		t.Errorf("expected POST request, got %s", req.Method)
+	}
+	if req.URL.Path != "/wapi/v2.12.3/network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default" {
+		t.Errorf("unexpected path: %s", req.URL.Path)
+	}
+	if f := req.URL.Query().Get("_function"); f != "next_available_ip" {
+		t.Errorf("expected 'next_available_ip' function, got '%s'", f)
+	}
+	var body map[string]interface{}
+	if err = json.Unmarshal([]byte(requestor.bodies[0]), &body); err != nil || body["num"] != float64(2) {
+		t.Errorf("unexpected body: %s", requestor.bodies[0])
+	}
+	if len(res.IPs) != 2 || res.IPs[0] != "10.0.0.5" {
+		t.Errorf("unexpected result: %v", res.IPs)
+	}
+}
@@ -0,0 +1,91 @@
+package infoblox
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+// defaultOperationTimeout is the time allowed for a resource to be created, updated or deleted,
+// unless it is overridden by the 'timeouts' block of the resource.
+const defaultOperationTimeout = 20 * time.Minute
+
+// withContext returns a copy of the provider's meta value, which sends the WAPI requests within the context.
+func (meta *providerMeta) withContext(ctx context.Context) *providerMeta {
+	res := *meta
+	res.connector = meta.connector.withContext(ctx)
+	res.IBConnector = res.connector
+
+	return &res
+}
+
+// metaWithContext binds the WAPI requests made using the meta value to the context.
+// Meta values other than the provider's one are returned as is.
+func metaWithContext(ctx context.Context, m interface{}) interface{} {
+	meta, ok := m.(*providerMeta)
+	if !ok || meta.connector == nil {
+		return m
+	}
+
+	return meta.withContext(ctx)
+}
+
+// wapiErrorsFromMeta returns the WAPI errors received by the operation the meta value is bound to.
+func wapiErrorsFromMeta(m interface{}) []*wapiError {
+	if meta, ok := m.(*providerMeta); ok && meta.connector != nil {
+		return meta.connector.receivedWapiErrors()
+	}
+
+	return nil
+}
+
+// withRequestContext binds the WAPI requests made by the operations of the resource to their contexts,
+// so that the cancellation of Terraform and the timeouts of the operations cancel the requests in flight.
+// The diagnostics of the WAPI errors get the hints and the paths of the arguments they are known to refer to.
+func withRequestContext(r *schema.Resource) *schema.Resource {
+	wrap := func(
+		op func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if op == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			m = metaWithContext(ctx, m)
+
+			return withWapiErrorDetails(op(ctx, d, m), wapiErrorsFromMeta(m))
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.ReadContext = wrap(r.ReadContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+
+	if imp := r.Importer; imp != nil && imp.StateContext != nil {
+		stateContext := imp.StateContext
+		imp.StateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			return stateContext(ctx, d, metaWithContext(ctx, m))
+		}
+	}
+
+	return r
+}
+
+// withTimeouts lets the time allowed for the operations of the resource be configured using a 'timeouts' block.
+func withTimeouts(r *schema.Resource) *schema.Resource {
+	if r.Timeouts != nil {
+		return r
+	}
+
+	r.Timeouts = &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultOperationTimeout),
+		Delete: schema.DefaultTimeout(defaultOperationTimeout),
+	}
+	if r.UpdateContext != nil {
+		r.Timeouts.Update = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+
+	return r
+}
@@ -0,0 +1,116 @@
+package infoblox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestMetaWithContext(t *testing.T) {
+	requestor := &testRequestor{response: `[{"_ref": "networkview/ZG5zLm5ldHdvcmtfdmlldyQw:default/true"}]`}
+	conn := newTestWapiConnector(requestor)
+	meta := &providerMeta{
+		IBConnector: conn,
+		connector:   conn,
+		defaultEAs:  map[string]interface{}{"Owner": "net-team"},
+	}
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "operation")
+	m := metaWithContext(ctx, meta)
+	if m == interface{}(meta) {
+		t.Fatalf("expected a copy of the meta value")
+	}
+	if defaultEAsFromMeta(m)["Owner"] != "net-team" {
+		t.Errorf("expected the settings of the provider to be kept, got %v", defaultEAsFromMeta(m))
+	}
+	if m.(*providerMeta).connector.requestor != conn.requestor {
+		t.Errorf("expected the requestor of the provider's connector to be shared")
+	}
+
+	var res []ibclient.NetworkView
+	if err := m.(ibclient.IBConnector).GetObject(ibclient.NewEmptyNetworkView(), "", nil, &res); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(requestor.requests) != 1 {
+		t.Fatalf("expected a single request, got %d", len(requestor.requests))
+	}
+	if v := requestor.requests[0].Context().Value(ctxKey{}); v != "operation" {
+		t.Errorf("expected the request to be sent within the context of the operation, got %v", v)
+	}
+
+	if m = metaWithContext(ctx, "other"); m != "other" {
+		t.Errorf("expected other meta values to be returned as is, got %v", m)
+	}
+}
+
+func TestWapiConnectorGetObject(t *testing.T) {
+	requestor := &testRequestor{response: `[]`}
+	conn := newTestWapiConnector(requestor)
+
+	var res []ibclient.NetworkView
+	err := conn.GetObject(ibclient.NewEmptyNetworkView(), "", ibclient.NewQueryParams(false, map[string]string{"name": "nv"}), &res)
+	if !isNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if len(requestor.requests) != 2 {
+		t.Fatalf("expected the search to be repeated, got %d requests", len(requestor.requests))
+	}
+	if q := requestor.requests[1].URL.Query(); q.Get("_proxy_search") != "GM" || q.Get("name") != "nv" {
+		t.Errorf("expected the search to be repeated on the Grid Master, got %s", requestor.requests[1].URL)
+	}
+
+	requestor = &testRequestor{response: `[]`}
+	conn = newTestWapiConnector(requestor)
+	if err = conn.GetObject(ibclient.NewEmptyNetworkView(), "", nil, &res); !isNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if len(requestor.requests) != 1 {
+		t.Errorf("expected a single request, got %d", len(requestor.requests))
+	}
+}
+
+func TestWithRequestContext(t *testing.T) {
+	type ctxKey struct{}
+	var called []string
+	op := func(name string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			called = append(called, name)
+			if ctx.Value(ctxKey{}) != "operation" {
+				t.Errorf("expected the context of the operation to be passed to %s", name)
+			}
+			if m.(*providerMeta).connector.ctx != ctx {
+				t.Errorf("expected the meta value to be bound to the context of %s", name)
+			}
+			return nil
+		}
+	}
+	r := withTimeouts(withRequestContext(&schema.Resource{
+		CreateContext: op("create"),
+		ReadContext:   op("read"),
+		DeleteContext: op("delete"),
+	}))
+
+	meta := &providerMeta{connector: newTestWapiConnector(&testRequestor{})}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "operation")
+	d := r.TestResourceData()
+	for _, op := range []func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics{
+		r.CreateContext, r.ReadContext, r.DeleteContext} {
+		if diags := op(ctx, d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+	}
+	if len(called) != 3 {
+		t.Errorf("expected the operations to be called, got %v", called)
+	}
+	if r.UpdateContext != nil {
+		t.Errorf("expected no update operation")
+	}
+
+	if r.Timeouts == nil || r.Timeouts.Create == nil || r.Timeouts.Delete == nil || r.Timeouts.Update != nil {
+		t.Errorf("expected the timeouts of create and delete only, got %+v", r.Timeouts)
+	}
+}
@@ -4,18 +4,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+// aRecordNaturalKey maps the arguments identifying the A-records to the WAPI search fields.
+var aRecordNaturalKey = map[string]string{
+	"fqdn":     "name",
+	"ip_addr":  "ipv4addr",
+	"dns_view": "view",
+}
+
 func resourceARecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceARecordCreate,
-		Read:   resourceARecordGet,
-		Update: resourceARecordUpdate,
-		Delete: resourceARecordDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceARecordCreate,
+		ReadContext:   resourceARecordGet,
+		UpdateContext: resourceARecordUpdate,
+		DeleteContext: resourceARecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceARecordImport,
+			StateContext: resourceARecordImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -93,13 +101,13 @@ func resourceARecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "network_view", "dns_view", "filter_params"), "record:a", aRecordNaturalKey), aRecordNaturalKey)
 }
 
-func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceARecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	networkView := d.Get("network_view").(string)
@@ -112,11 +120,11 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 	ipAddr := d.Get("ip_addr").(string)
 	nextAvailableFilter := d.Get("filter_params").(string)
 	if ipAddr == "" && cidr == "" && nextAvailableFilter == "" {
-		return fmt.Errorf("either of 'ip_addr' or 'cidr' or 'filter_params' values is required")
+		return diag.FromErr(fmt.Errorf("either of 'ip_addr' or 'cidr' or 'filter_params' values is required"))
 	}
 
 	if ipAddr != "" && cidr != "" && nextAvailableFilter == "" {
-		return fmt.Errorf("only one of 'ip_addr' or 'cidr' or 'filter_params' values is allowed to be defined")
+		return diag.FromErr(fmt.Errorf("only one of 'ip_addr' or 'cidr' or 'filter_params' values is allowed to be defined"))
 	}
 
 	var ttl uint32
@@ -127,15 +135,14 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -158,17 +165,17 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
 		eaMap["network_view"] = networkView
 		if err != nil {
-			return fmt.Errorf("error unmarshalling extra attributes of network container: %s", err)
+			return diag.FromErr(fmt.Errorf("error unmarshalling extra attributes of network container: %s", err))
 		}
 		rec, err := objMgr.AllocateNextAvailableIp(fqdn, "record:a", eaMap, nil, false, extAttrs, comment, false, nil, "IPV4",
 			false, false, "", "", networkView, dnsViewName, useTtl, ttl, nil)
 		if err != nil {
-			return fmt.Errorf("error allocating next available IP: %w", err)
+			return diag.FromErr(fmt.Errorf("error allocating next available IP: %w", err))
 		}
 		var ok bool
 		newRecord, ok = rec.(*ibclient.RecordA)
 		if !ok {
-			return fmt.Errorf("failed to convert rec to *ibclient.RecordA")
+			return diag.FromErr(fmt.Errorf("failed to convert rec to *ibclient.RecordA"))
 		}
 	} else {
 		newRecord, err = objMgr.CreateARecord(
@@ -182,41 +189,36 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 			comment,
 			extAttrs)
 		if err != nil {
-			return fmt.Errorf("creation of A-record under DNS view '%s' failed: %w", dnsViewName, err)
+			return diag.FromErr(fmt.Errorf("creation of A-record under DNS view '%s' failed: %w", dnsViewName, err))
 		}
 	}
 	d.SetId(newRecord.Ref)
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ip_addr", newRecord.Ipv4Addr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsViewObj, err := objMgr.GetDNSView(dnsViewName)
 		if err != nil {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %w",
-				dnsViewName, err)
+				dnsViewName, err))
 		}
 		if err = d.Set("network_view", dnsViewObj.NetworkView); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceARecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
 
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
@@ -228,8 +230,8 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
@@ -239,11 +241,11 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &recA)
 
 	if err != nil {
-		return fmt.Errorf("failed getting A-record: %w", err)
+		return diag.FromErr(fmt.Errorf("failed getting A-record: %w", err))
 	}
 
 	if err = d.Set("ip_addr", recA.Ipv4Addr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if recA.Ttl != nil {
@@ -253,45 +255,37 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	delete(recA.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(recA.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, recA.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("comment", recA.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("dns_view", recA.View); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", recA.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsView, err := objMgr.GetDNSView(recA.View)
 		if err != nil {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %w",
-				recA.View, err)
+				recA.View, err))
 		}
 		if err = d.Set("network_view", dnsView.NetworkView); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err = d.Set("fqdn", recA.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(recA.Ref)
@@ -299,7 +293,7 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceARecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -314,6 +308,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 			prevNextAvailableFilter, _ := d.GetChange("filter_params")
 
 			// TODO: move to the new Terraform plugin framework and
@@ -326,24 +321,11 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 			_ = d.Set("filter_params", prevNextAvailableFilter.(string))
 		}
 	}()
 
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-
-	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
-	}
-	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
-	}
-
 	networkView := d.Get("network_view").(string)
 	fqdn := d.Get("fqdn").(string)
 	cidr := d.Get("cidr").(string)
@@ -367,7 +349,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		if !cidrChanged {
 			cidr = ""
 		} else if ipaddrChanged && cidrChanged {
-			return fmt.Errorf("only one of 'ip_addr' and 'cidr' values is allowed to update")
+			return diag.FromErr(fmt.Errorf("only one of 'ip_addr' and 'cidr' values is allowed to update"))
 		} else {
 			ipAddr = ""
 		}
@@ -381,21 +363,14 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -409,7 +384,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 	// Get by Ref
 	recA, err := objMgr.GetARecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read A Record for update operation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read A Record for update operation: %w", err))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -422,7 +397,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(recA.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj, err := objMgr.UpdateARecord(
@@ -436,29 +411,28 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		comment,
 		newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("error updating A-record: %w", err)
+		return diag.FromErr(fmt.Errorf("error updating A-record: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(obj.Ref)
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ip_addr", obj.Ipv4Addr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceARecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -473,8 +447,8 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("A", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -488,17 +462,16 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteARecord(recA.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of A-record failed: %w", err)
+		return diag.FromErr(fmt.Errorf("deletion of A-record failed: %w", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceARecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -535,14 +508,8 @@ func resourceARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 	if err = d.Set("ttl", ttl); err != nil {
 		return nil, err
 	}
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
@@ -572,9 +539,8 @@ func resourceARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 
 	// Resource ARecord update Terraform Internal ID and Ref on NIOS side
 	// After the record is imported, call the update function
-	err = resourceARecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceARecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -4,18 +4,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+// aaaaRecordNaturalKey maps the arguments identifying the AAAA-records to the WAPI search fields.
+var aaaaRecordNaturalKey = map[string]string{
+	"fqdn":      "name",
+	"ipv6_addr": "ipv6addr",
+	"dns_view":  "view",
+}
+
 func resourceAAAARecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceAAAARecordCreate,
-		Read:   resourceAAAARecordGet,
-		Update: resourceAAAARecordUpdate,
-		Delete: resourceAAAARecordDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceAAAARecordCreate,
+		ReadContext:   resourceAAAARecordGet,
+		UpdateContext: resourceAAAARecordUpdate,
+		DeleteContext: resourceAAAARecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceAAAARecordImport,
+			StateContext: resourceAAAARecordImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -99,13 +107,13 @@ func resourceAAAARecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "network_view", "dns_view", "filter_params"), "record:aaaa", aaaaRecordNaturalKey), aaaaRecordNaturalKey)
 }
 
-func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceAAAARecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	networkView := d.Get("network_view").(string)
@@ -118,11 +126,11 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 	ipv6Addr := d.Get("ipv6_addr").(string)
 	nextAvailableFilter := d.Get("filter_params").(string)
 	if ipv6Addr == "" && cidr == "" && nextAvailableFilter == "" {
-		return fmt.Errorf("any one of 'ipv6_addr', 'cidr' and 'filter_params' values is required")
+		return diag.FromErr(fmt.Errorf("any one of 'ipv6_addr', 'cidr' and 'filter_params' values is required"))
 	}
 
 	if ipv6Addr != "" && cidr != "" && nextAvailableFilter != "" {
-		return fmt.Errorf("only one of 'ipv6_addr', 'cidr' and 'filter_params' values is allowed to be defined")
+		return diag.FromErr(fmt.Errorf("only one of 'ipv6_addr', 'cidr' and 'filter_params' values is allowed to be defined"))
 	}
 
 	var ttl uint32
@@ -133,15 +141,14 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -165,7 +172,7 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
 		eaMap["network_view"] = networkView
 		if err != nil {
-			return fmt.Errorf("error unmarshalling extra attributes of network: %s", err)
+			return diag.FromErr(fmt.Errorf("error unmarshalling extra attributes of network: %s", err))
 		}
 		newRecordAAAA, err = objMgr.AllocateNextAvailableIp(fqdn, "record:aaaa", eaMap, nil, false, extAttrs, comment, false, nil, "IPV6",
 			false, false, "", "", networkView, dnsViewName, false, ttl, nil)
@@ -173,43 +180,42 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 		newRecordAAAA, err = objMgr.CreateAAAARecord(networkView, dnsViewName, fqdn, cidr, ipv6Addr, useTtl, ttl, comment, extAttrs)
 	}
 	if err != nil {
-		return fmt.Errorf("creation of AAAA-record under DNS view '%s' failed: %w", dnsViewName, err)
+		return diag.FromErr(fmt.Errorf("creation of AAAA-record under DNS view '%s' failed: %w", dnsViewName, err))
 	}
 
 	recordAAAA := newRecordAAAA.(*ibclient.RecordAAAA)
 	d.SetId(recordAAAA.Ref)
 
 	if err = d.Set("ref", recordAAAA.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ipv6_addr", recordAAAA.Ipv6Addr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsViewObj, err := objMgr.GetDNSView(dnsViewName)
 		if err != nil {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %w",
-				dnsViewName, err)
+				dnsViewName, err))
 		}
 		if err = d.Set("network_view", dnsViewObj.NetworkView); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceAAAARecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -223,8 +229,8 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("AAAA", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -237,10 +243,10 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil && obj.Ref != "" {
-		return fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err))
 	}
 	if err = d.Set("ipv6_addr", obj.Ipv6Addr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if obj.Ttl != nil {
@@ -250,47 +256,38 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, obj.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsView, err := objMgr.GetDNSView(obj.View)
 		if err != nil {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %w",
-				obj.View, err)
+				obj.View, err))
 		}
 		if err = d.Set("network_view", dnsView.NetworkView); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err = d.Set("fqdn", obj.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(obj.Ref)
@@ -298,7 +295,7 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceAAAARecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -314,6 +311,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("dns_view", prevDNSView.(string))
@@ -324,26 +322,11 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 
 		}
 	}()
 
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-
-	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
-	}
-
-	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
-	}
-
 	networkView := d.Get("network_view").(string)
 	fqdn := d.Get("fqdn").(string)
 	cidr := d.Get("cidr").(string)
@@ -368,7 +351,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		if !cidrChanged {
 			cidr = ""
 		} else if ipaddrChanged && cidrChanged {
-			return fmt.Errorf("only one of 'ipv6_addr' and 'cidr' values is allowed to update")
+			return diag.FromErr(fmt.Errorf("only one of 'ipv6_addr' and 'cidr' values is allowed to update"))
 		} else {
 			ipv6Addr = ""
 		}
@@ -382,21 +365,14 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -409,7 +385,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	qarec, err := objMgr.GetAAAARecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read AAAA Record for update operation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read AAAA Record for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -423,7 +399,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(qarec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordAAAA, err := objMgr.UpdateAAAARecord(
@@ -437,31 +413,30 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		comment,
 		newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("error updating AAAA-record: %w", err)
+		return diag.FromErr(fmt.Errorf("error updating AAAA-record: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(recordAAAA.Ref)
 	if err = d.Set("ref", recordAAAA.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ipv6_addr", recordAAAA.Ipv6Addr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
+func resourceAAAARecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	dnsView := d.Get("dns_view").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -475,8 +450,8 @@ func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
 	qarec, err := searchObjectByRefOrInternalId("AAAA", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -489,22 +464,21 @@ func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil {
-		return fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err))
 	}
 
 	_, err = objMgr.DeleteAAAARecord(obj.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of AAAA Record from dns view %s failed: %w", dnsView, err)
+		return diag.FromErr(fmt.Errorf("deletion of AAAA Record from dns view %s failed: %w", dnsView, err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceAAAARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceAAAARecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -542,15 +516,8 @@ func resourceAAAARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.
 		return nil, err
 	}
 
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
@@ -578,9 +545,8 @@ func resourceAAAARecordImport(d *schema.ResourceData, m interface{}) ([]*schema.
 
 	d.SetId(obj.Ref)
 
-	err = resourceAAAARecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceAAAARecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
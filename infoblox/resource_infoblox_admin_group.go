@@ -0,0 +1,196 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+var (
+	adminGroupRegExp = regexp.MustCompile("^admingroup/.+")
+
+	adminGroupAccessMethods = []string{"GUI", "API", "TAXII", "CLOUD_API"}
+	adminGroupReturnFields  = []string{
+		"name", "comment", "superuser", "roles", "access_method", "disable", "email_addresses"}
+)
+
+func resourceAdminGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAdminGroupCreate,
+		ReadContext:   resourceAdminGroupRead,
+		UpdateContext: resourceAdminGroupUpdate,
+		DeleteContext: resourceAdminGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the admin group.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the admin group; maximum 256 characters.",
+			},
+			"superuser": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the admin group is a superuser group, which can perform all operations on the appliance.",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the admin roles assigned to the admin group.",
+			},
+			"access_method": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(adminGroupAccessMethods, false),
+				},
+				Description: "The access methods the members of the admin group may use: GUI, API, TAXII and CLOUD_API.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the admin group is disabled.",
+			},
+			"email_addresses": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The e-mail addresses of the admin group.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func expandAdminGroup(d *schema.ResourceData) *wapiRawObject {
+	fields := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"comment":         d.Get("comment").(string),
+		"superuser":       d.Get("superuser").(bool),
+		"disable":         d.Get("disable").(bool),
+		"roles":           expandStringList(d.Get("roles").([]interface{})),
+		"email_addresses": expandStringList(d.Get("email_addresses").([]interface{})),
+	}
+	if accessMethods, ok := d.GetOk("access_method"); ok {
+		fields["access_method"] = expandStringList(accessMethods.([]interface{}))
+	}
+
+	return newWapiRawObject("admingroup", fields)
+}
+
+func resourceAdminGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	ref, err := conn.CreateObject(expandAdminGroup(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of admin group '%s' failed: %w", d.Get("name").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceAdminGroupRead(ctx, d, m)
+}
+
+func resourceAdminGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !adminGroupRegExp.MatchString(d.Id()) {
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'admingroup' object has an invalid format", d.Id()))
+	}
+
+	conn := m.(ibclient.IBConnector)
+
+	ag := &ibclient.Admingroup{}
+	ag.SetReturnFields(adminGroupReturnFields)
+	var res ibclient.Admingroup
+	if err := conn.GetObject(ag, d.Id(), nil, &res); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read admin group '%s': %w", d.Id(), err))
+	}
+
+	for field, value := range flattenAdminGroup(res) {
+		if field == "id" {
+			continue
+		}
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("ref", res.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(res.Ref)
+
+	return nil
+}
+
+func resourceAdminGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	ref, err := conn.UpdateObject(expandAdminGroup(d), d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of admin group '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourceAdminGroupRead(ctx, d, m)
+}
+
+func resourceAdminGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of admin group '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func flattenAdminGroup(ag ibclient.Admingroup) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":              ag.Ref,
+		"roles":           ag.Roles,
+		"access_method":   ag.AccessMethod,
+		"email_addresses": ag.EmailAddresses,
+		"comment":         "",
+		"superuser":       false,
+		"disable":         false,
+	}
+	if ag.Name != nil {
+		res["name"] = *ag.Name
+	}
+	if ag.Comment != nil {
+		res["comment"] = *ag.Comment
+	}
+	if ag.Superuser != nil {
+		res["superuser"] = *ag.Superuser
+	}
+	if ag.Disable != nil {
+		res["disable"] = *ag.Disable
+	}
+
+	return res
+}
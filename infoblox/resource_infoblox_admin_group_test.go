@@ -0,0 +1,82 @@
+package infoblox
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"testing"
+)
+
+// testAccCheckObjectsDestroyed returns a check that all the resources of the given type
+// do not exist on NIOS side anymore. Suitable for the resources whose ID is a NIOS reference.
+func testAccCheckObjectsDestroyed(resourceType string, obj func() ibclient.IBObject) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(ibclient.IBConnector)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			var res interface{}
+			err := conn.GetObject(obj(), rs.Primary.ID, nil, &res)
+			if err == nil {
+				return fmt.Errorf("object with reference '%s' still exists", rs.Primary.ID)
+			}
+			if !isNotFoundError(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckAdminGroupDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_admin_group", func() ibclient.IBObject {
+		return &ibclient.Admingroup{}
+	})(s)
+}
+
+func TestAcc_resourceAdminGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_admin_group" "test_group" {
+						name = "tf_acc_test_group"
+						comment = "admin group created by acceptance tests"
+						access_method = ["GUI", "API"]
+						email_addresses = ["admins@example.com"]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_admin_group.test_group", "name", "tf_acc_test_group"),
+					resource.TestCheckResourceAttr("infoblox_admin_group.test_group", "superuser", "false"),
+					resource.TestCheckResourceAttr("infoblox_admin_group.test_group", "access_method.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_admin_group.test_group", "email_addresses.0", "admins@example.com"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_admin_group" "test_group" {
+						name = "tf_acc_test_group"
+						comment = "superuser group"
+						superuser = true
+						access_method = ["API"]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_admin_group.test_group", "comment", "superuser group"),
+					resource.TestCheckResourceAttr("infoblox_admin_group.test_group", "superuser", "true"),
+					resource.TestCheckResourceAttr("infoblox_admin_group.test_group", "access_method.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_admin_group.test_group", "email_addresses.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "infoblox_admin_group.test_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,149 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+var (
+	adminRoleRegExp       = regexp.MustCompile("^adminrole/.+")
+	adminRoleReturnFields = []string{"name", "comment", "disable"}
+)
+
+func resourceAdminRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAdminRoleCreate,
+		ReadContext:   resourceAdminRoleRead,
+		UpdateContext: resourceAdminRoleUpdate,
+		DeleteContext: resourceAdminRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the admin role.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the admin role; maximum 256 characters.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the admin role is disabled.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func expandAdminRole(d *schema.ResourceData) *wapiRawObject {
+	return newWapiRawObject("adminrole", map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"comment": d.Get("comment").(string),
+		"disable": d.Get("disable").(bool),
+	})
+}
+
+func resourceAdminRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	ref, err := conn.CreateObject(expandAdminRole(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of admin role '%s' failed: %w", d.Get("name").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceAdminRoleRead(ctx, d, m)
+}
+
+func resourceAdminRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !adminRoleRegExp.MatchString(d.Id()) {
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'adminrole' object has an invalid format", d.Id()))
+	}
+
+	conn := m.(ibclient.IBConnector)
+
+	ar := &ibclient.Adminrole{}
+	ar.SetReturnFields(adminRoleReturnFields)
+	var res ibclient.Adminrole
+	if err := conn.GetObject(ar, d.Id(), nil, &res); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read admin role '%s': %w", d.Id(), err))
+	}
+
+	for field, value := range flattenAdminRole(res) {
+		if field == "id" {
+			continue
+		}
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("ref", res.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(res.Ref)
+
+	return nil
+}
+
+func resourceAdminRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	ref, err := conn.UpdateObject(expandAdminRole(d), d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of admin role '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourceAdminRoleRead(ctx, d, m)
+}
+
+func resourceAdminRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of admin role '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func flattenAdminRole(ar ibclient.Adminrole) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":      ar.Ref,
+		"comment": "",
+		"disable": false,
+	}
+	if ar.Name != nil {
+		res["name"] = *ar.Name
+	}
+	if ar.Comment != nil {
+		res["comment"] = *ar.Comment
+	}
+	if ar.Disable != nil {
+		res["disable"] = *ar.Disable
+	}
+
+	return res
+}
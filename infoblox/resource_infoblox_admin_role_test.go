@@ -0,0 +1,47 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"testing"
+)
+
+func testAccCheckAdminRoleDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_admin_role", func() ibclient.IBObject {
+		return &ibclient.Adminrole{}
+	})(s)
+}
+
+func TestAcc_resourceAdminRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_admin_role" "test_role" {
+						name = "tf_acc_test_role"
+						comment = "admin role created by acceptance tests"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_admin_role.test_role", "name", "tf_acc_test_role"),
+					resource.TestCheckResourceAttr("infoblox_admin_role.test_role", "disable", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_admin_role" "test_role" {
+						name = "tf_acc_test_role_renamed"
+						comment = "renamed admin role"
+						disable = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_admin_role.test_role", "name", "tf_acc_test_role_renamed"),
+					resource.TestCheckResourceAttr("infoblox_admin_role.test_role", "disable", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,238 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+var (
+	adminUserRegExp       = regexp.MustCompile("^adminuser/.+")
+	adminUserAuthTypes    = []string{"LOCAL", "REMOTE", "SAML", "SAML_LOCAL"}
+	adminUserReturnFields = []string{"name", "comment", "admin_groups", "auth_type", "email", "disable"}
+)
+
+func resourceAdminUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAdminUserCreate,
+		ReadContext:   resourceAdminUserRead,
+		UpdateContext: resourceAdminUserUpdate,
+		DeleteContext: resourceAdminUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeDiffAdminUserPassword,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the admin user.",
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				WriteOnly: true,
+				Description: "The password of the admin user. It is write-only: it is never stored in the state " +
+					"and never read back from NIOS. It is sent to NIOS on creation and whenever 'password_version' changes. " +
+					"Required for LOCAL and SAML_LOCAL authentication types.",
+			},
+			"password_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Change the value of the field to send the value of 'password' to NIOS on the update.",
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "LOCAL",
+				ValidateFunc: validation.StringInSlice(adminUserAuthTypes, false),
+				Description:  "The authentication type of the admin user: LOCAL, REMOTE, SAML or SAML_LOCAL.",
+			},
+			"admin_groups": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The name of the admin group the admin user belongs to. Only one admin group is supported by NIOS.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the admin user; maximum 256 characters.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The e-mail address of the admin user.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the admin user is disabled.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// customizeDiffAdminUserPassword requires the password of the admin users with the local authentication,
+// when they are created or switched to such an authentication type. The password is write-only,
+// so it is taken from the configuration.
+func customizeDiffAdminUserPassword(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	authType := d.Get("auth_type").(string)
+	if authType != "LOCAL" && authType != "SAML_LOCAL" {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("auth_type") {
+		return nil
+	}
+
+	password, diags := d.GetRawConfigAt(cty.GetAttrPath("password"))
+	if diags.HasError() || !password.IsKnown() {
+		return nil
+	}
+	if password.IsNull() || password.AsString() == "" {
+		return fmt.Errorf("'password' is required for %s authentication type", authType)
+	}
+
+	return nil
+}
+
+func expandAdminUser(d *schema.ResourceData, withPassword bool) (*wapiRawObject, error) {
+	fields := map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"auth_type":    d.Get("auth_type").(string),
+		"admin_groups": expandStringList(d.Get("admin_groups").([]interface{})),
+		"comment":      d.Get("comment").(string),
+		"email":        d.Get("email").(string),
+		"disable":      d.Get("disable").(bool),
+	}
+	if withPassword {
+		password, err := getWriteOnlyString(d, "password")
+		if err != nil {
+			return nil, err
+		}
+		if password != "" {
+			fields["password"] = password
+		}
+	}
+
+	return newWapiRawObject("adminuser", fields), nil
+}
+
+func resourceAdminUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	au, err := expandAdminUser(d, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ref, err := conn.CreateObject(au)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of admin user '%s' failed: %w", d.Get("name").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceAdminUserRead(ctx, d, m)
+}
+
+func resourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !adminUserRegExp.MatchString(d.Id()) {
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'adminuser' object has an invalid format", d.Id()))
+	}
+
+	conn := m.(ibclient.IBConnector)
+
+	au := &ibclient.Adminuser{}
+	au.SetReturnFields(adminUserReturnFields)
+	var res ibclient.Adminuser
+	if err := conn.GetObject(au, d.Id(), nil, &res); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read admin user '%s': %w", d.Id(), err))
+	}
+
+	for field, value := range flattenAdminUser(res) {
+		if field == "id" {
+			continue
+		}
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("ref", res.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(res.Ref)
+
+	return nil
+}
+
+func resourceAdminUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	// The password is sent only when its version is changed, to avoid resetting
+	// the password expiration timer of the admin user on every update.
+	au, err := expandAdminUser(d, d.HasChange("password_version"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ref, err := conn.UpdateObject(au, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of admin user '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourceAdminUserRead(ctx, d, m)
+}
+
+func resourceAdminUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of admin user '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func flattenAdminUser(au ibclient.Adminuser) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":           au.Ref,
+		"admin_groups": au.AdminGroups,
+		"auth_type":    au.AuthType,
+		"comment":      "",
+		"email":        "",
+		"disable":      false,
+	}
+	if au.Name != nil {
+		res["name"] = *au.Name
+	}
+	if au.Comment != nil {
+		res["comment"] = *au.Comment
+	}
+	if au.Email != nil {
+		res["email"] = *au.Email
+	}
+	if au.Disable != nil {
+		res["disable"] = *au.Disable
+	}
+
+	return res
+}
@@ -0,0 +1,123 @@
+package infoblox
+
+import (
+	"context"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"strings"
+	"testing"
+)
+
+func testAccCheckAdminUserDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_admin_user", func() ibclient.IBObject {
+		return &ibclient.Adminuser{}
+	})(s)
+}
+
+func TestAcc_resourceAdminUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAdminUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_admin_group" "test_group" {
+						name = "tf_acc_test_user_group"
+					}
+					resource "infoblox_admin_user" "test_user" {
+						name = "tf_acc_test_user"
+						password = "Infoblox@123"
+						admin_groups = [infoblox_admin_group.test_group.name]
+						email = "user@example.com"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_admin_user.test_user", "name", "tf_acc_test_user"),
+					resource.TestCheckResourceAttr("infoblox_admin_user.test_user", "auth_type", "LOCAL"),
+					resource.TestCheckResourceAttr("infoblox_admin_user.test_user", "admin_groups.0", "tf_acc_test_user_group"),
+					resource.TestCheckResourceAttr("infoblox_admin_user.test_user", "email", "user@example.com"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_admin_group" "test_group" {
+						name = "tf_acc_test_user_group"
+					}
+					resource "infoblox_admin_user" "test_user" {
+						name = "tf_acc_test_user"
+						password = "Infoblox@456"
+						password_version = 2
+						admin_groups = [infoblox_admin_group.test_group.name]
+						comment = "password changed"
+						disable = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_admin_user.test_user", "comment", "password changed"),
+					resource.TestCheckResourceAttr("infoblox_admin_user.test_user", "email", ""),
+					resource.TestCheckResourceAttr("infoblox_admin_user.test_user", "disable", "true"),
+					resource.TestCheckNoResourceAttr("infoblox_admin_user.test_user", "password"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_admin_user.test_user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+		},
+	})
+}
+
+func TestCustomizeDiffAdminUserPassword(t *testing.T) {
+	r := resourceAdminUser()
+	// The raw configuration, which has the write-only values, is passed along with the prior state, like Terraform does.
+	config := func(state *terraform.InstanceState, authType, password string) (*terraform.InstanceState, *terraform.ResourceConfig) {
+		values := map[string]cty.Value{
+			"name":         cty.StringVal("tf-admin"),
+			"auth_type":    cty.StringVal(authType),
+			"admin_groups": cty.ListVal([]cty.Value{cty.StringVal("admin-group")}),
+			"password":     cty.NullVal(cty.String),
+		}
+		if password != "" {
+			values["password"] = cty.StringVal(password)
+		}
+		for name, attrType := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+			if _, ok := values[name]; !ok {
+				values[name] = cty.NullVal(attrType)
+			}
+		}
+		configVal := cty.ObjectVal(values)
+		if state == nil {
+			state = &terraform.InstanceState{}
+		}
+		state.RawConfig = configVal
+
+		return state, terraform.NewResourceConfigShimmed(configVal, r.CoreConfigSchema())
+	}
+
+	for _, tc := range []struct {
+		state    *terraform.InstanceState
+		authType string
+		password string
+		err      bool
+	}{
+		{nil, "LOCAL", "", true},
+		{nil, "SAML_LOCAL", "", true},
+		{nil, "LOCAL", "secret", false},
+		{nil, "REMOTE", "", false},
+		{&terraform.InstanceState{ID: "adminuser/b25l:tf-admin", Attributes: map[string]string{
+			"name": "tf-admin", "auth_type": "LOCAL", "admin_groups.#": "1", "admin_groups.0": "admin-group",
+		}}, "LOCAL", "", false},
+		{&terraform.InstanceState{ID: "adminuser/b25l:tf-admin", Attributes: map[string]string{
+			"name": "tf-admin", "auth_type": "REMOTE", "admin_groups.#": "1", "admin_groups.0": "admin-group",
+		}}, "LOCAL", "", true},
+	} {
+		state, cfg := config(tc.state, tc.authType, tc.password)
+		_, err := r.Diff(context.Background(), state, cfg, &providerMeta{})
+		if tc.err != (err != nil && strings.Contains(err.Error(), "'password' is required")) {
+			t.Errorf("auth type %s, password '%s', state %v: unexpected result %v", tc.authType, tc.password, tc.state, err)
+		}
+	}
+}
@@ -4,18 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceAliasRecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceAliasRecordCreate,
-		Read:   resourceAliasRecordRead,
-		Update: resourceAliasRecordUpdate,
-		Delete: resourceAliasRecordDelete,
+	return withImmutableFields(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceAliasRecordCreate,
+		ReadContext:   resourceAliasRecordRead,
+		UpdateContext: resourceAliasRecordUpdate,
+		DeleteContext: resourceAliasRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceAliasRecordImport,
+			StateContext: resourceAliasRecordImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -85,13 +86,13 @@ func resourceAliasRecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "dns_view")
 }
 
-func resourceAliasRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceAliasRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	name := d.Get("name").(string)
@@ -109,13 +110,12 @@ func resourceAliasRecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to allocate IP: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to allocate IP: %w", err))
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -133,25 +133,20 @@ func resourceAliasRecordCreate(d *schema.ResourceData, m interface{}) error {
 	// create alias record
 	aliasRecord, err := objMgr.CreateAliasRecord(name, dnsView, targetName, targetType, comment, disable, extAttrs, ttl, useTtl)
 	if err != nil {
-		return fmt.Errorf("failed to create alias record: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create alias record: %w", err))
 	}
 	d.SetId(aliasRecord.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", aliasRecord.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceAliasRecordRead(d, m)
+	return resourceAliasRecordRead(ctx, d, m)
 }
 
-func resourceAliasRecordRead(d *schema.ResourceData, m interface{}) error {
+func resourceAliasRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
 
 	rec, err := searchObjectByRefOrInternalId("AliasRecord", d, m)
 	if err != nil {
@@ -159,60 +154,52 @@ func resourceAliasRecordRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 	var recordAlias *ibclient.RecordAlias
 
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Alias record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal Alias record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &recordAlias)
 	if err != nil {
-		return fmt.Errorf("failed getting Alias record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting Alias record : %s", err.Error()))
 	}
 
 	delete(recordAlias.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(recordAlias.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, recordAlias.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if recordAlias.Name != nil {
 		if err = d.Set("name", *recordAlias.Name); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if recordAlias.Comment != nil {
 		if err = d.Set("comment", *recordAlias.Comment); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if recordAlias.Disable != nil {
 		if err = d.Set("disable", *recordAlias.Disable); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if recordAlias.TargetName != nil {
 		if err = d.Set("target_name", *recordAlias.TargetName); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err = d.Set("target_type", recordAlias.TargetType); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if recordAlias.View != nil {
 		if err = d.Set("dns_view", *recordAlias.View); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -224,11 +211,11 @@ func resourceAliasRecordRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ref", recordAlias.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(recordAlias.Ref)
@@ -236,7 +223,7 @@ func resourceAliasRecordRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceAliasRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceAliasRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -248,6 +235,7 @@ func resourceAliasRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevDnsView, _ := d.GetChange("dns_view")
 			prevTTL, _ := d.GetChange("ttl")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("name", prevName)
 			_ = d.Set("comment", prevComment)
@@ -257,19 +245,13 @@ func resourceAliasRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("dns_view", prevDnsView)
 			_ = d.Set("ttl", prevTTL)
 			_ = d.Set("ext_attrs", prevExtAttrs)
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -292,11 +274,7 @@ func resourceAliasRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
-	}
-
-	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -307,8 +285,8 @@ func resourceAliasRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("AliasRecord", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -316,11 +294,11 @@ func resourceAliasRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal alias record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal alias record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &recordAlias)
 	if err != nil {
-		return fmt.Errorf("failed getting alias record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting alias record : %s", err.Error()))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -333,32 +311,31 @@ func resourceAliasRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(recordAlias.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updatedRecord, err := objMgr.UpdateAliasRecord(d.Id(), name, dnsView, targetName, targetType, comment, disable, newExtAttrs, ttl, useTtl)
 	if err != nil {
-		return fmt.Errorf("Failed to update alias Record with %s, ", err.Error())
+		return diag.FromErr(fmt.Errorf("Failed to update alias Record with %s, ", err.Error()))
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", updatedRecord.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(updatedRecord.Ref)
 
 	return nil
 }
 
-func resourceAliasRecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceAliasRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -373,8 +350,8 @@ func resourceAliasRecordDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("AliasRecord", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -384,23 +361,22 @@ func resourceAliasRecordDelete(d *schema.ResourceData, m interface{}) error {
 	var aliasRecord *ibclient.RecordAlias
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal alias record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal alias record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &aliasRecord)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = objMgr.DeleteAliasRecord(aliasRecord.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete alias : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to delete alias : %s", err.Error()))
 	}
 	return nil
 }
 
-func resourceAliasRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceAliasRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+	_, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -413,14 +389,8 @@ func resourceAliasRecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 		return nil, fmt.Errorf("failed getting alias record: %w", err)
 	}
 
-	if aliasRecord.Ea != nil && len(aliasRecord.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(aliasRecord.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, aliasRecord.Ea); err != nil {
+		return nil, err
 	}
 	delete(aliasRecord.Ea, eaNameForInternalId)
 
@@ -468,10 +438,8 @@ func resourceAliasRecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 		return nil, err
 	}
 	d.SetId(aliasRecord.Ref)
-	err = resourceAliasRecordUpdate(d, m)
-
-	if err != nil {
-		return nil, err
+	if diags := resourceAliasRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"github.com/infobloxopen/infoblox-go-client/v2/utils"
 	"regexp"
@@ -13,16 +14,35 @@ import (
 
 var (
 	dnsViewRegExp = regexp.MustCompile("^view/.+")
+
+	dnsViewAccessControlFields = []string{"match_clients", "match_destinations"}
+	dnsViewConfigFields        = []string{
+		"recursion", "use_recursion",
+		"forwarders", "forward_only", "use_forwarders",
+		"enable_blacklist", "blacklist_action", "blacklist_log_query", "blacklist_redirect_addresses",
+		"blacklist_redirect_ttl", "blacklist_rulesets", "use_blacklist",
+		"dns64_enabled", "dns64_groups", "use_dns64",
+		"root_name_server_type", "custom_root_name_servers", "use_root_name_server",
+	}
+
+	dnsViewBlacklistActions    = []string{"REDIRECT", "REFUSE"}
+	dnsViewRootNameServerTypes = []string{"CUSTOM", "INTERNET"}
 )
 
+// dnsViewNaturalKey maps the arguments identifying the DNS views to the WAPI search fields.
+var dnsViewNaturalKey = map[string]string{
+	"name":         "name",
+	"network_view": "network_view",
+}
+
 func resourceDNSView() *schema.Resource {
-	return &schema.Resource{
+	return withWapiFieldAttributes(withAdoptExisting(withExtensibleAttributes(&schema.Resource{
 		CreateContext: resourceDNSViewCreate,
 		ReadContext:   resourceDNSViewRead,
 		UpdateContext: resourceDNSViewUpdate,
 		DeleteContext: resourceDNSViewDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDNSViewImport,
+			StateContext: resourceDNSViewImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -61,6 +81,158 @@ func resourceDNSView() *schema.Resource {
 				Description: "The Extensible attributes of the DNS view to be added/updated, as a map in JSON format",
 			},
 
+			"match_clients": accessControlListSchema(
+				"The ordered list of access control entries which determine the clients served by the DNS view: " +
+					"IPv4/IPv6 addresses, networks, TSIG keys and named ACLs."),
+
+			"match_destinations": accessControlListSchema(
+				"The ordered list of access control entries which determine the destination addresses " +
+					"of the queries served by the DNS view."),
+
+			"recursion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Determines whether recursive queries are allowed for the DNS view.",
+			},
+
+			"use_recursion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use flag for: recursion",
+			},
+
+			"forwarders": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ordered list of IP addresses of the forwarders for the DNS view.",
+			},
+
+			"forward_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				Description: "Determines whether the queries are sent to the forwarders only, " +
+					"and not to other internal or Internet root servers.",
+			},
+
+			"use_forwarders": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use flag for: forwarders, forward_only",
+			},
+
+			"enable_blacklist": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Determines whether the blacklist is enabled for the DNS view.",
+			},
+
+			"blacklist_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dnsViewBlacklistActions, false),
+				Description:  "The action to perform when a domain name matches the blacklist: REDIRECT or REFUSE.",
+			},
+
+			"blacklist_log_query": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Determines whether the queries matching the blacklist are logged.",
+			},
+
+			"blacklist_redirect_addresses": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IP addresses the blacklisted queries are redirected to.",
+			},
+
+			"blacklist_redirect_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL value of the synthetic DNS responses to the redirected queries.",
+			},
+
+			"blacklist_rulesets": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the blacklist rulesets of the DNS view.",
+			},
+
+			"use_blacklist": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Use flag for: enable_blacklist, blacklist_action, blacklist_log_query, " +
+					"blacklist_redirect_addresses, blacklist_redirect_ttl, blacklist_rulesets",
+			},
+
+			"dns64_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Determines whether the DNS64 support is enabled for the DNS view.",
+			},
+
+			"dns64_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the DNS64 synthesis groups of the DNS view.",
+			},
+
+			"use_dns64": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use flag for: dns64_enabled, dns64_groups",
+			},
+
+			"root_name_server_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dnsViewRootNameServerTypes, false),
+				Description:  "The type of the root name servers of the DNS view: CUSTOM or INTERNET.",
+			},
+
+			"custom_root_name_servers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "The custom root name servers of the DNS view, " +
+					"used when 'root_name_server_type' is CUSTOM.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The domain name of the root name server.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IPv4 or IPv6 address of the root name server.",
+						},
+					},
+				},
+			},
+
+			"use_root_name_server": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use flag for: root_name_server_type, custom_root_name_servers",
+			},
+
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -75,7 +247,7 @@ func resourceDNSView() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "view", dnsViewNaturalKey), dnsViewNaturalKey)
 }
 
 func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -89,8 +261,7 @@ func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	conn := m.(ibclient.IBConnector)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -112,7 +283,16 @@ func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interf
 		v.NetworkView = utils.StringPtr(d.Get("network_view").(string))
 	}
 
-	viewRef, err := conn.CreateObject(v)
+	config, err := expandDNSViewConfig(d, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	viewObj, err := withWapiFields(v, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	viewRef, err := conn.CreateObject(viewObj)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -174,24 +354,13 @@ func resourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interfac
 		}
 	}
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
-	if err != nil {
+	if err = readDNSViewConfig(d, m, vResult.Ref); err != nil {
 		return diag.FromErr(err)
 	}
 
 	delete(vResult.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(vResult.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return diag.FromErr(err)
-		}
+	if err = terraformSetEAs(d, m, vResult.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", vResult.Ref); err != nil {
 		return diag.FromErr(err)
@@ -202,24 +371,14 @@ func resourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChange("internal_id") {
-		return diag.FromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
-	}
-
-	if d.HasChange("ref") {
+	// The reference is set before the update only when an existing view is adopted on creation.
+	if !d.IsNewResource() && d.HasChange("ref") {
 		return diag.FromErr(fmt.Errorf("changing the value of 'ref' field is not allowed"))
 	}
 
 	conn := m.(ibclient.IBConnector)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -261,7 +420,16 @@ func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	vUpd.Ea = mergedExtAttrs
 
-	viewRef, err := conn.UpdateObject(vUpd, d.Id())
+	config, err := expandDNSViewConfig(d, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	viewObj, err := withWapiFields(vUpd, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	viewRef, err := conn.UpdateObject(viewObj, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -308,7 +476,7 @@ func resourceDNSViewDelete(ctx context.Context, d *schema.ResourceData, m interf
 	return nil
 }
 
-func resourceDNSViewImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceDNSViewImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	conn := m.(ibclient.IBConnector)
 
 	viewRef := d.Id()
@@ -343,31 +511,143 @@ func resourceDNSViewImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 		}
 	}
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	_, err = terraformDeserializeEAs(extAttrsJSON)
+	_, err = terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
 
-	if vResult.Ea != nil && len(vResult.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(vResult.Ea)
-		if err != nil {
-			return nil, err
+	if err = terraformImportEAs(d, vResult.Ea); err != nil {
+		return nil, err
+	}
+
+	d.SetId(vResult.Ref)
+
+	if diags := resourceDNSViewUpdate(ctx, d, m); diags.HasError() {
+		return nil, fmt.Errorf("failed to import DNS View: %w", diagnosticsError(diags))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// dnsViewConfigReturnFields returns the fields of a DNS view, which are not covered
+// by the return fields of the go-client's object manager.
+func dnsViewConfigReturnFields() []string {
+	fields := append([]string{}, dnsViewAccessControlFields...)
+
+	return append(fields, dnsViewConfigFields...)
+}
+
+// readDNSViewConfig sets the recursion, forwarding, blacklist, DNS64, root name servers
+// and access control settings of the resource from the DNS view.
+func readDNSViewConfig(d *schema.ResourceData, m interface{}, ref string) error {
+	v := &ibclient.View{}
+	v.SetReturnFields(dnsViewConfigReturnFields())
+	var res ibclient.View
+	if err := m.(ibclient.IBConnector).GetObject(v, ref, ibclient.NewQueryParams(false, nil), &res); err != nil {
+		return fmt.Errorf("failed to read the configuration of DNS View '%s': %w", ref, err)
+	}
+
+	for field, value := range flattenDNSViewConfig(res) {
+		if err := d.Set(field, value); err != nil {
+			return err
 		}
+	}
 
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
+	return nil
+}
+
+// expandDNSViewConfig returns the changed recursion, forwarding, blacklist, DNS64, root name servers
+// and access control settings of the resource as WAPI fields, to be sent along with the DNS view.
+// On creation of the DNS view (isNew) the settings specified in the configuration are returned.
+func expandDNSViewConfig(d *schema.ResourceData, isNew bool) (map[string]interface{}, error) {
+	fields, err := changedAccessControlLists(d, dnsViewAccessControlFields, isNew, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rawConfig := d.GetRawConfig()
+	for _, f := range dnsViewConfigFields {
+		if isNew {
+			if rawConfig.IsNull() || rawConfig.GetAttr(f).IsNull() {
+				continue
+			}
+		} else if !d.HasChange(f) {
+			continue
+		}
+
+		switch v := d.Get(f).(type) {
+		case []interface{}:
+			if f == "custom_root_name_servers" {
+				fields[f] = expandNameServers(v)
+			} else {
+				fields[f] = expandStringList(v)
+			}
+		default:
+			fields[f] = v
 		}
 	}
 
-	d.SetId(vResult.Ref)
+	return fields, nil
+}
 
-	var dErr diag.Diagnostics
-	var ctx context.Context
-	dErr = resourceDNSViewUpdate(ctx, d, m)
-	if dErr != nil {
-		return nil, fmt.Errorf("failed to import DNS View: %v", dErr)
+func expandNameServers(list []interface{}) []ibclient.NameServer {
+	res := make([]ibclient.NameServer, 0, len(list))
+	for _, v := range list {
+		if ns, ok := v.(map[string]interface{}); ok {
+			res = append(res, ibclient.NameServer{
+				Name:    ns["name"].(string),
+				Address: ns["address"].(string),
+			})
+		}
 	}
 
-	return []*schema.ResourceData{d}, nil
+	return res
+}
+
+func flattenNameServers(nameServers []ibclient.NameServer) []interface{} {
+	res := make([]interface{}, 0, len(nameServers))
+	for _, ns := range nameServers {
+		res = append(res, map[string]interface{}{
+			"name":    ns.Name,
+			"address": ns.Address,
+		})
+	}
+
+	return res
+}
+
+func flattenDNSViewConfig(v ibclient.View) map[string]interface{} {
+	res := map[string]interface{}{
+		"match_clients":                flattenAccessControlList(v.MatchClients),
+		"match_destinations":           flattenAccessControlList(v.MatchDestinations),
+		"forwarders":                   v.Forwarders,
+		"blacklist_action":             v.BlacklistAction,
+		"blacklist_redirect_addresses": v.BlacklistRedirectAddresses,
+		"blacklist_rulesets":           v.BlacklistRulesets,
+		"dns64_groups":                 v.Dns64Groups,
+		"root_name_server_type":        v.RootNameServerType,
+		"custom_root_name_servers":     flattenNameServers(v.CustomRootNameServers),
+		"blacklist_redirect_ttl":       0,
+	}
+
+	bools := map[string]*bool{
+		"recursion":            v.Recursion,
+		"use_recursion":        v.UseRecursion,
+		"forward_only":         v.ForwardOnly,
+		"use_forwarders":       v.UseForwarders,
+		"enable_blacklist":     v.EnableBlacklist,
+		"blacklist_log_query":  v.BlacklistLogQuery,
+		"use_blacklist":        v.UseBlacklist,
+		"dns64_enabled":        v.Dns64Enabled,
+		"use_dns64":            v.UseDns64,
+		"use_root_name_server": v.UseRootNameServer,
+	}
+	for field, value := range bools {
+		res[field] = value != nil && *value
+	}
+	if v.BlacklistRedirectTtl != nil {
+		res["blacklist_redirect_ttl"] = int(*v.BlacklistRedirectTtl)
+	}
+
+	return res
 }
@@ -317,3 +317,74 @@ func testAccCheckDNSViewDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestAcc_resourceDNSView_configuration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_dns_view" "split_view" {
+						name = "tf_acc_test_split_view"
+						match_clients {
+							address = "10.0.0.0/8"
+						}
+						match_clients {
+							address = "Any"
+							permission = "DENY"
+						}
+						match_destinations {
+							address = "192.168.1.10"
+						}
+						recursion = true
+						use_recursion = true
+						forwarders = ["10.10.0.1", "10.10.0.2"]
+						forward_only = true
+						use_forwarders = true
+						root_name_server_type = "CUSTOM"
+						custom_root_name_servers {
+							name = "root1.example.com"
+							address = "10.20.0.1"
+						}
+						use_root_name_server = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "match_clients.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "match_clients.1.address", "Any"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "match_clients.1.permission", "DENY"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "match_destinations.0.address", "192.168.1.10"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "recursion", "true"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "forwarders.1", "10.10.0.2"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "forward_only", "true"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "root_name_server_type", "CUSTOM"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "custom_root_name_servers.0.name", "root1.example.com"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_dns_view" "split_view" {
+						name = "tf_acc_test_split_view"
+						match_clients {
+							address = "10.0.0.0/8"
+						}
+						recursion = false
+						use_recursion = true
+						dns64_enabled = true
+						use_dns64 = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "match_clients.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "match_destinations.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "recursion", "false"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "forwarders.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "use_forwarders", "false"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "custom_root_name_servers.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "use_root_name_server", "false"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.split_view", "dns64_enabled", "true"),
+				),
+			},
+		},
+	})
+}
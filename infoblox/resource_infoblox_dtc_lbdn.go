@@ -4,19 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
 func resourceDtcLbdnRecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceDtcLbdnCreate,
-		Read:   resourceDtcLbdnGet,
-		Update: resourceDtcLbdnUpdate,
-		Delete: resourceDtcLbdnDelete,
+	return withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceDtcLbdnCreate,
+		ReadContext:   resourceDtcLbdnGet,
+		UpdateContext: resourceDtcLbdnUpdate,
+		DeleteContext: resourceDtcLbdnDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDtcLbdnImport,
+			StateContext: resourceDtcLbdnImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -171,7 +172,7 @@ func resourceDtcLbdnRecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	})
 }
 
 func validateAuthZonesLink(authZones []interface{}) ([]ibclient.AuthZonesLink, error) {
@@ -200,17 +201,17 @@ func validateAuthZonesLink(authZones []interface{}) ([]ibclient.AuthZonesLink, e
 	return authZoneList, nil
 }
 
-func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
+func resourceDtcLbdnCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	name := d.Get("name").(string)
 	authZones := d.Get("auth_zones").([]interface{})
 	authZonesLink, err := validateAuthZonesLink(authZones)
 	if err != nil {
-		return fmt.Errorf("failed to validate auth_zones: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to validate auth_zones: %w", err))
 	}
 
 	autoConsolidatedMonitors := d.Get("auto_consolidated_monitors").(bool)
@@ -221,7 +222,7 @@ func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
 
 	pools, err := validatePoolsLink(poolsLink)
 	if err != nil {
-		return fmt.Errorf("failed to validate pools: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to validate pools: %w", err))
 	}
 
 	patterns := d.Get("patterns").([]interface{})
@@ -240,7 +241,7 @@ func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
 	types := d.Get("types").([]interface{})
 	typesList := make([]string, len(types))
 	if len(types) == 0 {
-		return fmt.Errorf("at least one record type should be selected")
+		return diag.FromErr(fmt.Errorf("at least one record type should be selected"))
 	}
 	for i, j := range types {
 		typesList[i] = j.(string)
@@ -254,13 +255,12 @@ func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to allocate IP: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to allocate IP: %w", err))
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -278,27 +278,22 @@ func resourceDtcLbdnCreate(d *schema.ResourceData, m interface{}) error {
 	// Create the DTC LBDN record
 	newRecord, err := objMgr.CreateDtcLbdn(name, authZonesLink, comment, disable, autoConsolidatedMonitors, extAttrs, lbMethod, patternsList, persistence, pools, priority, &topology, typesList, ttl, useTtl)
 	if err != nil {
-		return fmt.Errorf("failed to create DTC LBDN record: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create DTC LBDN record: %w", err))
 	}
 	d.SetId(newRecord.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceDtcLbdnGet(d, m)
+	return resourceDtcLbdnGet(ctx, d, m)
 }
 
-func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
+func resourceDtcLbdnGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
 
 	rec, err := searchObjectByRefOrInternalId("DtcLbdn", d, m)
 	if err != nil {
@@ -306,8 +301,8 @@ func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
@@ -317,91 +312,83 @@ func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
 
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &dtcLbdn)
 	if err != nil {
-		return fmt.Errorf("failed getting DTC LBDN record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting DTC LBDN record : %s", err.Error()))
 	}
 
 	delete(dtcLbdn.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(dtcLbdn.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, dtcLbdn.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if dtcLbdn.Name != nil {
 		if err = d.Set("name", *dtcLbdn.Name); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if dtcLbdn.AuthZones != nil {
 		authZoneInterface, err := ConvertAuthZonesToInterface(connector, dtcLbdn)
 		if err != nil {
-			return fmt.Errorf("failed to convert auth zones to interface: %w", err)
+			return diag.FromErr(fmt.Errorf("failed to convert auth zones to interface: %w", err))
 		}
 		if err = d.Set("auth_zones", authZoneInterface); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if dtcLbdn.AutoConsolidatedMonitors != nil {
 		if err = d.Set("auto_consolidated_monitors", *dtcLbdn.AutoConsolidatedMonitors); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if dtcLbdn.Comment != nil {
 		if err = d.Set("comment", *dtcLbdn.Comment); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if dtcLbdn.Disable != nil {
 		if err = d.Set("disable", *dtcLbdn.Disable); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if dtcLbdn.LbMethod != "" {
 		if err = d.Set("lb_method", dtcLbdn.LbMethod); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if dtcLbdn.Patterns != nil {
 		listInterface = convertSliceToInterface(dtcLbdn.Patterns)
 		if err = d.Set("patterns", listInterface); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	listInterface = convertSliceToInterface(dtcLbdn.Types)
 	if err = d.Set("types", listInterface); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dtcLbdn.Persistence != nil {
 		if err = d.Set("persistence", *dtcLbdn.Persistence); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if dtcLbdn.Priority != nil {
 		if err = d.Set("priority", *dtcLbdn.Priority); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if dtcLbdn.Pools != nil {
 		poolsInterface, err := convertPoolsToInterface(dtcLbdn, connector)
 		if err != nil {
-			return fmt.Errorf("failed to convert pools to interface: %w", err)
+			return diag.FromErr(fmt.Errorf("failed to convert pools to interface: %w", err))
 		}
 		if err = d.Set("pools", poolsInterface); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -409,10 +396,10 @@ func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
 		var res ibclient.DtcTopology
 		err := connector.GetObject(&ibclient.DtcTopology{}, *dtcLbdn.Topology, nil, &res)
 		if err != nil {
-			return fmt.Errorf("failed to get %s topology: %w", *dtcLbdn.Topology, err)
+			return diag.FromErr(fmt.Errorf("failed to get %s topology: %w", *dtcLbdn.Topology, err))
 		}
 		if err = d.Set("topology", *res.Name); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if dtcLbdn.Ttl != nil {
@@ -422,11 +409,11 @@ func resourceDtcLbdnGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ref", dtcLbdn.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dtcLbdn.Ref)
@@ -485,7 +472,7 @@ func ConvertAuthZonesToInterface(connector ibclient.IBConnector, dtcLbdn *ibclie
 	return authZoneList, nil
 }
 
-func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceDtcLbdnUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -503,6 +490,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTypes, _ := d.GetChange("types")
 			prevTtl, _ := d.GetChange("ttl")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("name", prevName.(string))
 			_ = d.Set("auth_zones", prevAuthZones)
@@ -518,19 +506,13 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("types", prevTypes)
 			_ = d.Set("ttl", prevTtl.(int))
 			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -542,7 +524,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 	authZones := d.Get("auth_zones").([]interface{})
 	authZonesLink, err := validateAuthZonesLink(authZones)
 	if err != nil {
-		return fmt.Errorf("failed to validate auth_zones: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to validate auth_zones: %w", err))
 	}
 
 	autoConsolidatedMonitors := d.Get("auto_consolidated_monitors").(bool)
@@ -553,7 +535,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 
 	pools, err := validatePoolsLink(poolsLink)
 	if err != nil {
-		return fmt.Errorf("failed to validate pools: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to validate pools: %w", err))
 	}
 
 	patterns := d.Get("patterns").([]interface{})
@@ -572,7 +554,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 	types := d.Get("types").([]interface{})
 	typesList := make([]string, len(types))
 	if len(types) == 0 {
-		return fmt.Errorf("at least one record type should be selected")
+		return diag.FromErr(fmt.Errorf("at least one record type should be selected"))
 	}
 	for i, j := range types {
 		typesList[i] = j.(string)
@@ -586,7 +568,7 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -597,8 +579,8 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("DtcLbdn", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -606,11 +588,11 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &lbdn)
 	if err != nil {
-		return fmt.Errorf("failed getting DTC LBDN record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting DTC LBDN record : %s", err.Error()))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -623,31 +605,30 @@ func resourceDtcLbdnUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(lbdn.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lbdn, err = objMgr.UpdateDtcLbdn(d.Id(), name, authZonesLink, comment, disable, autoConsolidatedMonitors, newExtAttrs, lbMethod, patternsList, persistence, pools, priority, &topology, typesList, ttl, useTtl)
 	if err != nil {
-		return fmt.Errorf("failed to update DTC LBDN: %s.", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to update DTC LBDN: %s.", err.Error()))
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", lbdn.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(lbdn.Ref)
-	return resourceDtcLbdnGet(d, m)
+	return resourceDtcLbdnGet(ctx, d, m)
 }
 
-func resourceDtcLbdnDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceDtcLbdnDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -662,8 +643,8 @@ func resourceDtcLbdnDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("DtcLbdn", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -673,23 +654,22 @@ func resourceDtcLbdnDelete(d *schema.ResourceData, m interface{}) error {
 	var lbdn *ibclient.DtcLbdn
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal DTC LBDN record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &lbdn)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = objMgr.DeleteDtcLbdn(lbdn.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete DTC LBDN : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to delete DTC LBDN : %s", err.Error()))
 	}
 
 	return nil
 }
 
-func resourceDtcLbdnImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceDtcLbdnImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -702,14 +682,8 @@ func resourceDtcLbdnImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 		return nil, fmt.Errorf("failed getting DTC LBDN record: %w", err)
 	}
 
-	if lbdn.Ea != nil && len(lbdn.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(lbdn.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, lbdn.Ea); err != nil {
+		return nil, err
 	}
 	delete(lbdn.Ea, eaNameForInternalId)
 
@@ -807,9 +781,8 @@ func resourceDtcLbdnImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 	d.SetId(lbdn.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceDtcLbdnUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceDtcLbdnUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -4,20 +4,27 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"reflect"
 	"strings"
 )
 
+// fixedAddressNaturalKey maps the arguments identifying the fixed addresses to the WAPI search fields.
+var fixedAddressNaturalKey = map[string]string{
+	"ipv4addr":     "ipv4addr",
+	"network_view": "network_view",
+}
+
 func resourceFixedRecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceFixedRecordCreate,
-		Read:   resourceFixedRecordRead,
-		Update: resourceFixedRecordUpdate,
-		Delete: resourceFixedRecordDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withInheritableExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceFixedRecordCreate,
+		ReadContext:   resourceFixedRecordRead,
+		UpdateContext: resourceFixedRecordUpdate,
+		DeleteContext: resourceFixedRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceFixedRecordImport,
+			StateContext: resourceFixedRecordImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -234,12 +241,12 @@ func resourceFixedRecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "network_view"), "fixedaddress", fixedAddressNaturalKey), fixedAddressNaturalKey)
 }
-func resourceFixedRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceFixedRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	agentCircuitId := d.Get("agent_circuit_id").(string)
 	agentRemoteId := d.Get("agent_remote_id").(string)
@@ -252,25 +259,24 @@ func resourceFixedRecordCreate(d *schema.ResourceData, m interface{}) error {
 	mac := d.Get("mac").(string)
 	matchClient := d.Get("match_client").(string)
 	if matchClient == "MAC_ADDRESS" && mac == "" {
-		return fmt.Errorf("MAC address is required when match_client set to MAC_ADDRESS")
+		return diag.FromErr(fmt.Errorf("MAC address is required when match_client set to MAC_ADDRESS"))
 	}
 	name := d.Get("name").(string)
 	network := d.Get("network").(string)
 	if ipAddr == "" && network == "" {
-		return fmt.Errorf("either 'ipv4addr' or 'network' fields needs to provided to allocate a fixed address")
+		return diag.FromErr(fmt.Errorf("either 'ipv4addr' or 'network' fields needs to provided to allocate a fixed address"))
 	}
 	networkView := d.Get("network_view").(string)
 
 	optionsInterface := d.Get("options").([]interface{})
 	options, err := validateDhcpOptions(optionsInterface)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	useOptions := d.Get("use_options").(bool)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -282,34 +288,31 @@ func resourceFixedRecordCreate(d *schema.ResourceData, m interface{}) error {
 		tenantID = tempVal.(string)
 	}
 
-	connector := m.(ibclient.IBConnector)
+	connector, err := connectorWithEAInheritance(m.(ibclient.IBConnector), d, m, "fixedaddress", extAttrs, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	fixedAddress, err := objMgr.AllocateIP(networkView, network, ipAddr, false, mac, name, comment, extAttrs, matchClient, agentCircuitId, agentRemoteId, clientIdentifierPrependZero, dhcpClientIdentifier, disable, options, useOptions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fixedAddress.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", fixedAddress.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceFixedRecordRead(d, m)
+	return resourceFixedRecordRead(ctx, d, m)
 }
-func resourceFixedRecordRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
-
+func resourceFixedRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rec, err := searchObjectByRefOrInternalId("FixedAddress", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -318,95 +321,91 @@ func resourceFixedRecordRead(d *schema.ResourceData, m interface{}) error {
 	var fixedAddress *ibclient.FixedAddress
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal fixed address : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal fixed address : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &fixedAddress)
 	if err != nil {
-		return fmt.Errorf("failed getting fixed address : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting fixed address : %s", err.Error()))
 	}
 
 	delete(fixedAddress.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(fixedAddress.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	inheritedEAs, err := readInheritedEAs(d, m, fixedAddress.Ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = terraformSetInheritableEAs(d, m, fixedAddress.Ea, inheritedEAs); err != nil {
+		return diag.FromErr(err)
 	}
 	if err = d.Set("comment", fixedAddress.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("disable", fixedAddress.Disable); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ipv4addr", fixedAddress.IPv4Address); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if fixedAddress.MatchClient != nil && (*fixedAddress.MatchClient == "MAC_ADDRESS" || *fixedAddress.MatchClient == "RESERVED") {
 		if err = d.Set("mac", fixedAddress.Mac); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err = d.Set("match_client", fixedAddress.MatchClient); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("name", fixedAddress.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("network", fixedAddress.Cidr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("network_view", fixedAddress.NetviewName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if fixedAddress.MatchClient != nil && *fixedAddress.MatchClient == "CIRCUIT_ID" {
 		if err = d.Set("agent_circuit_id", fixedAddress.AgentCircuitId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err = d.Set("agent_circuit_id", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if fixedAddress.MatchClient != nil && *fixedAddress.MatchClient == "REMOTE_ID" {
 		if err = d.Set("agent_remote_id", fixedAddress.AgentRemoteId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err = d.Set("agent_remote_id", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if fixedAddress.MatchClient != nil && *fixedAddress.MatchClient == "CLIENT_ID" {
 		if err = d.Set("client_identifier_prepend_zero", fixedAddress.ClientIdentifierPrependZero); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("dhcp_client_identifier", fixedAddress.DhcpClientIdentifier); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err = d.Set("client_identifier_prepend_zero", false); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("dhcp_client_identifier", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err = d.Set("use_options", fixedAddress.UseOptions); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("options", convertDhcpOptionsToInterface(fixedAddress.Options)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fixedAddress.Ref)
 	return nil
 }
-func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceFixedRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -428,6 +427,7 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevUseOption, _ := d.GetChange("use_options")
 			prevOptions, _ := d.GetChange("options")
 			prevExtAttrsJSON, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("disable", prevDisable.(bool))
@@ -444,16 +444,10 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("use_options", prevUseOption.(bool))
 			_ = d.Set("options", prevOptions)
 			_ = d.Set("ext_attrs", prevExtAttrsJSON.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 
 		}
 	}()
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-
 	network := d.Get("network").(string)
 	ipv4addr := d.Get("ipv4addr").(string)
 	if d.HasChange("ipv4addr") && d.HasChange("network") {
@@ -473,16 +467,9 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	clientIdentifierPrependZero := &clientIdentifierPrependZeroBool
 	dhcpClientIdentifier := d.Get("dhcp_client_identifier").(string)
 	useOptions := d.Get("use_options").(bool)
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -490,28 +477,19 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		tenantID = tempVal.(string)
 	}
 	connector := m.(ibclient.IBConnector)
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
-	var fixedAddress *ibclient.FixedAddress
-
-	rec, err := searchObjectByRefOrInternalId("FixedAddress", d, m)
+	// The EAs are read along with their inheritance, to keep inheriting the EAs not managed by the resource.
+	fixedAddress := ibclient.NewEmptyFixedAddress(false)
+	inheritedEAs, err := getObjectWithInheritedEAs(connector, fixedAddress, d.Id())
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
 		}
 	}
-	recJson, err := json.Marshal(rec)
-	if err != nil {
-		return fmt.Errorf("failed to marshal fixedAddress : %s", err.Error())
-	}
-	err = json.Unmarshal(recJson, &fixedAddress)
-	if err != nil {
-		return fmt.Errorf("failed getting fixed addresss: %s", err.Error())
-	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
 	internalId := d.Get("internal_id").(string)
@@ -522,6 +500,14 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	newExtAttrs[eaNameForInternalId] = newInternalId.String()
 
 	newExtAttrs, err = mergeEAs(fixedAddress.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	connector, err = connectorWithEAInheritance(connector, d, m, "fixedaddress", newExtAttrs, inheritedEAs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	// Check if the options field has changes
 	oldOptions, newOptions := d.GetChange("options")
@@ -529,34 +515,33 @@ func resourceFixedRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	newList, okNew := newOptions.([]interface{})
 
 	if !okOld || !okNew {
-		return fmt.Errorf("options is not a slice of interfaces")
+		return diag.FromErr(fmt.Errorf("options is not a slice of interfaces"))
 	}
 
 	optimizedOptions := optimizeDhcpOptions(oldList, newList)
 	options, err := validateDhcpOptions(optimizedOptions)
 	if err != nil {
-		return fmt.Errorf("failed to validate options: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to validate options: %w", err))
 	}
 
 	fixedAddress, err = objMgr.UpdateFixedAddress(d.Id(), networkView, name, network, ipv4addr, matchClient, mac, comment, newExtAttrs, agentCircuitId, agentRemoteId, clientIdentifierPrependZero, dhcpClientIdentifier, disable, options, useOptions)
 	if err != nil {
-		return fmt.Errorf("error updating Fixed address: %w", err)
+		return diag.FromErr(fmt.Errorf("error updating Fixed address: %w", err))
 	}
 	updateSuccessful = true
 	d.SetId(fixedAddress.Ref)
 	if err = d.Set("ref", fixedAddress.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceFixedRecordRead(d, m)
+	return resourceFixedRecordRead(ctx, d, m)
 }
-func resourceFixedRecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceFixedRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -571,8 +556,8 @@ func resourceFixedRecordDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("FixedAddress", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -586,16 +571,15 @@ func resourceFixedRecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteARecord(fixedAddress.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of Fixed address failed: %w", err)
+		return diag.FromErr(fmt.Errorf("deletion of Fixed address failed: %w", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceFixedRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceFixedRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -619,14 +603,11 @@ func resourceFixedRecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 	if err = d.Set("disable", obj.Disable); err != nil {
 		return nil, err
 	}
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = removeInheritedEAs(m, obj.Ref, obj.Ea); err != nil {
+		return nil, err
+	}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 	if err = d.Set("ipv4addr", obj.IPv4Address); err != nil {
 		return nil, err
@@ -668,9 +649,8 @@ func resourceFixedRecordImport(d *schema.ResourceData, m interface{}) ([]*schema
 	d.SetId(obj.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceFixedRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceFixedRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,250 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"strconv"
+	"time"
+)
+
+var gridServiceRestartRequestReturnFields = []string{
+	"error", "forced", "group", "member", "needed", "order", "result", "service", "state"}
+
+// gridServiceRestartRequestsSchema is the schema of the restart requests, which represent
+// the restart of a service on a member.
+func gridServiceRestartRequestsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The restart requests of the services on the grid members.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"group": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the restart group associated with the request.",
+				},
+				"member": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The member to restart.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The service to restart.",
+				},
+				"needed": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Indicates whether the restart is needed.",
+				},
+				"forced": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Indicates whether the restart is forced.",
+				},
+				"order": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The order of the restart.",
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The state of the request.",
+				},
+				"result": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The result of the restart.",
+				},
+				"error": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The error message if the restart has failed.",
+				},
+			},
+		},
+	}
+}
+
+// resourceGridServiceRestart requests a restart of the services on the grid members.
+// The restart is requested on creation of the resource and whenever its arguments change;
+// the 'triggers' map may be used to request a restart on changes of other resources.
+func resourceGridServiceRestart() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGridServiceRestartCreate,
+		ReadContext:   resourceGridServiceRestartRead,
+		DeleteContext: resourceGridServiceRestartDelete,
+
+		Schema: map[string]*schema.Schema{
+			"services": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(restartServices, false),
+				},
+				Description: "The services to restart: ALL, DNS, DHCP, DHCPV4 or DHCPV6. All the services by default.",
+			},
+			"restart_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      restartPolicyIfNeeded,
+				ValidateFunc: validation.StringInSlice([]string{restartPolicyIfNeeded, restartPolicyForce}, false),
+				Description: "'if_needed' restarts the services on the members which require it, " +
+					"'force' restarts the services on all the members.",
+			},
+			"groups": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"members"},
+				Description:   "The names of the restart groups to restart the services on.",
+			},
+			"members": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"groups"},
+				Description:   "The host names of the grid members to restart the services on.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(restartModes, false),
+				Description:  "The restart method: GROUPED, SEQUENTIAL or SIMULTANEOUS.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values, a change of which requests another restart.",
+			},
+			"requests": gridServiceRestartRequestsSchema(),
+		},
+	}
+}
+
+// getGridServiceRestartGroup returns the restart group with the given name.
+func getGridServiceRestartGroup(conn ibclient.IBConnector, name string) (*ibclient.GridServicerestartGroup, error) {
+	group := &ibclient.GridServicerestartGroup{}
+	group.SetReturnFields([]string{"name", "members", "service", "status"})
+	var res []ibclient.GridServicerestartGroup
+	qp := ibclient.NewQueryParams(false, map[string]string{"name": name})
+	if err := conn.GetObject(group, "", qp, &res); err != nil && !isNotFoundError(err) {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, ibclient.NewNotFoundError(fmt.Sprintf("restart group '%s' not found", name))
+	}
+
+	return &res[0], nil
+}
+
+// getGridServiceRestartRequests returns the restart requests, optionally of the given groups or members only.
+func getGridServiceRestartRequests(
+	conn ibclient.IBConnector, groups, members []string) ([]ibclient.GridServicerestartRequest, error) {
+
+	req := &ibclient.GridServicerestartRequest{}
+	req.SetReturnFields(gridServiceRestartRequestReturnFields)
+	var res []ibclient.GridServicerestartRequest
+	if err := conn.GetObject(req, "", ibclient.NewQueryParams(false, nil), &res); err != nil && !isNotFoundError(err) {
+		return nil, err
+	}
+
+	contains := func(list []string, value string) bool {
+		for _, v := range list {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+	filtered := make([]ibclient.GridServicerestartRequest, 0, len(res))
+	for _, r := range res {
+		if len(groups) > 0 && !contains(groups, r.Group) || len(members) > 0 && !contains(members, r.Member) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+
+	return filtered, nil
+}
+
+func flattenGridServiceRestartRequests(requests []ibclient.GridServicerestartRequest) []interface{} {
+	res := make([]interface{}, 0, len(requests))
+	for _, r := range requests {
+		res = append(res, map[string]interface{}{
+			"group":   r.Group,
+			"member":  r.Member,
+			"service": r.Service,
+			"needed":  r.Needed,
+			"forced":  r.Forced,
+			"order":   r.Order,
+			"state":   r.State,
+			"result":  r.Result,
+			"error":   r.Error,
+		})
+	}
+
+	return res
+}
+
+func resourceGridServiceRestartCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	restart := gridServiceRestart{
+		RestartOption: restartOptions[d.Get("restart_option").(string)],
+		Services:      expandStringList(d.Get("services").([]interface{})),
+		Groups:        expandStringList(d.Get("groups").([]interface{})),
+		Members:       expandStringList(d.Get("members").([]interface{})),
+		Mode:          d.Get("mode").(string),
+	}
+	if len(restart.Services) == 0 {
+		restart.Services = []string{restartServiceAll}
+	}
+	for _, name := range restart.Groups {
+		if _, err := getGridServiceRestartGroup(conn, name); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read restart group '%s': %w", name, err))
+		}
+	}
+
+	if err := restartGridServices(conn, restart); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strconv.FormatInt(time.Now().UnixNano(), 10))
+
+	return resourceGridServiceRestartRead(ctx, d, m)
+}
+
+func resourceGridServiceRestartRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	requests, err := getGridServiceRestartRequests(m.(ibclient.IBConnector),
+		expandStringList(d.Get("groups").([]interface{})), expandStringList(d.Get("members").([]interface{})))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read restart requests: %w", err))
+	}
+	if err = d.Set("requests", flattenGridServiceRestartRequests(requests)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGridServiceRestartDelete only removes the resource from the state: a restart cannot be undone.
+func resourceGridServiceRestartDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
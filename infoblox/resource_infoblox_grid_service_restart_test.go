@@ -0,0 +1,90 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAcc_resourceGridServiceRestart(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_grid_service_restart" "restart" {
+						services = ["DNS"]
+						members = ["infoblox.localdomain"]
+						triggers = {
+							zone = "test-restart.com"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_grid_service_restart.restart", "restart_option", "if_needed"),
+					resource.TestCheckResourceAttr("infoblox_grid_service_restart.restart", "services.0", "DNS"),
+					resource.TestCheckResourceAttrSet("infoblox_grid_service_restart.restart", "id"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_grid_service_restart" "restart" {
+						services = ["DNS", "DHCP"]
+						restart_option = "force"
+						members = ["infoblox.localdomain"]
+						triggers = {
+							zone = "test-restart2.com"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_grid_service_restart.restart", "restart_option", "force"),
+					resource.TestCheckResourceAttr("infoblox_grid_service_restart.restart", "services.#", "2"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_grid_service_restart" "restart" {
+						groups = ["non-existent-restart-group"]
+					}`,
+				ExpectError: regexp.MustCompile("restart group 'non-existent-restart-group' not found"),
+			},
+		},
+	})
+}
+
+func TestAcc_resourceGridServiceRestart_providerPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					provider "infoblox" {
+						restart_services {
+							dns = "if_needed"
+							delay = 1
+						}
+					}
+
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "test-restart-policy.com"
+					}
+
+					resource "infoblox_a_record" "rec" {
+						fqdn = "a.test-restart-policy.com"
+						ip_addr = "10.0.0.1"
+						depends_on = [infoblox_zone_auth.zone]
+					}
+
+					data "infoblox_grid_service_restart_status" "status" {
+						depends_on = [infoblox_a_record.rec]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.rec", "ip_addr", "10.0.0.1"),
+					resource.TestCheckResourceAttrSet("data.infoblox_grid_service_restart_status.status", "needed_restart"),
+				),
+			},
+		},
+	})
+}
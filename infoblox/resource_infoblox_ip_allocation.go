@@ -1,12 +1,14 @@
 package infoblox
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 
@@ -34,16 +36,22 @@ func normalizeAndSortAliases(aliases []interface{}, domain string, enableDNS boo
 	return normalizedAliases
 }
 
+// ipAllocationWapiFields maps the arguments of the IP allocations to the fields of their host records.
+var ipAllocationWapiFields = map[string]string{
+	"fqdn":     "name",
+	"dns_view": "view",
+	"aliases":  "aliases",
+}
+
 func resourceIPAllocation() *schema.Resource {
-	// TODO: move towards context-aware equivalents of these fields, as these are deprecated.
-	return &schema.Resource{
-		Create: resourceAllocationRequest,
-		Read:   resourceAllocationGet,
-		Update: resourceAllocationUpdate,
-		Delete: resourceAllocationRelease,
+	return withWapiFieldAttributes(withImmutableFieldsUnlessChanged(withImmutableFields(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceAllocationRequest,
+		ReadContext:   resourceAllocationGet,
+		UpdateContext: resourceAllocationUpdate,
+		DeleteContext: resourceAllocationRelease,
 
 		Importer: &schema.ResourceImporter{
-			State: ipAllocationImporter,
+			StateContext: ipAllocationImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -200,7 +208,7 @@ func resourceIPAllocation() *schema.Resource {
 				},
 			},
 		},
-	}
+	}), "network_view", "filter_params", "ip_address_type"), "enable_dns", "dns_view"), ipAllocationWapiFields)
 }
 
 // This function is for retrieving a host record by either known reference or,
@@ -242,13 +250,13 @@ func getOrFindHostRec(d *schema.ResourceData, m interface{}) (
 	return objMgr.SearchHostRecordByAltId(actualIntId.String(), ref, eaNameForInternalId)
 }
 
-func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
+func resourceAllocationRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	networkView := d.Get("network_view").(string)
 	dnsView := d.Get("dns_view").(string)
 	enableDns := d.Get("enable_dns").(bool)
 	fqdn := d.Get("fqdn").(string)
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	ipv4Cidr := d.Get("ipv4_cidr").(string)
@@ -259,13 +267,13 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	ipAdressType := d.Get("ip_address_type").(string)
 	if nextAvailableFilter == "" {
 		if err := d.Set("ip_address_type", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 
 		}
 	}
 	if (ipv4Cidr == "" && ipv6Cidr == "" && ipv4Addr == "" && ipv6Addr == "") && nextAvailableFilter == "" {
-		return fmt.Errorf("allocation through host address record creation needs an IPv4/IPv6 address" +
-			" or IPv4/IPv6 cidr or filter_params")
+		return diag.FromErr(fmt.Errorf("allocation through host address record creation needs an IPv4/IPv6 address" +
+			" or IPv4/IPv6 cidr or filter_params"))
 	}
 
 	ZeroMacAddr := "00:00:00:00:00:00"
@@ -287,16 +295,15 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 	disable := d.Get("disable").(bool)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to allocate IP: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to allocate IP: %w", err))
 	}
 
 	var tenantID string
@@ -320,7 +327,7 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
 		eaMap["network_view"] = networkView
 		if err != nil {
-			return fmt.Errorf("error unmarshalling extra attributes of network: %s", err)
+			return diag.FromErr(fmt.Errorf("error unmarshalling extra attributes of network: %s", err))
 		}
 		newRecordHost, err = objMgr.AllocateNextAvailableIp(fqdn, "record:host", eaMap, nil, false, extAttrs,
 			comment, disable, nil, ipAdressType, enableDns, false, "", "", networkView, dnsView, useTtl, ttl, aliasStrs)
@@ -334,27 +341,27 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error while creating a host record: %s", err.Error())
+		return diag.FromErr(fmt.Errorf("error while creating a host record: %s", err.Error()))
 	}
 	hostRec := newRecordHost.(*ibclient.HostRecord)
 
 	d.SetId(internalId.String())
 	if err = d.Set("ref", hostRec.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// For compatibility reason. This field should be deprecated in the future.
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hostRec.Ipv6Addrs == nil || len(hostRec.Ipv6Addrs) < 1 {
 		if err := d.Set("allocated_ipv6_addr", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv6_addr", hostRec.Ipv6Addrs[0].Ipv6Addr); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -365,22 +372,22 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("aliases", aliasesInterface); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if hostRec.Ipv4Addrs == nil || len(hostRec.Ipv4Addrs) < 1 {
 		if err := d.Set("allocated_ipv4_addr", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv4_addr", hostRec.Ipv4Addrs[0].Ipv4Addr); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceAllocationGet(d, m)
+	return resourceAllocationGet(ctx, d, m)
 }
 
-func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
+func resourceAllocationGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
 	obj, err := getOrFindHostRec(d, m)
 	if err != nil {
@@ -389,37 +396,37 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 			return nil
 		}
 
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, nextAvailableFilterOk := d.GetOk("filter_params")
 	if obj.Ipv6Addrs == nil || len(obj.Ipv6Addrs) < 1 {
 		if err := d.Set("allocated_ipv6_addr", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv6_addr", obj.Ipv6Addrs[0].Ipv6Addr); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_, found := d.GetOk("ipv6_cidr")
 		if !found && !nextAvailableFilterOk {
 			if err := d.Set("ipv6_addr", obj.Ipv6Addrs[0].Ipv6Addr); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 	if obj.Ipv4Addrs == nil || len(obj.Ipv4Addrs) < 1 {
 		if err := d.Set("allocated_ipv4_addr", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv4_addr", obj.Ipv4Addrs[0].Ipv4Addr); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_, found := d.GetOk("ipv4_cidr")
 		if !found && !nextAvailableFilterOk {
 			if err := d.Set("ipv4_addr", obj.Ipv4Addrs[0].Ipv4Addr); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -431,51 +438,37 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("aliases", aliasesInterface); err != nil {
-		return err
-	}
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
 
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, obj.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("network_view", obj.NetworkView); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("enable_dns", obj.EnableDns); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("fqdn", obj.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("disable", obj.Disable); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if obj.Ttl != nil {
@@ -485,17 +478,17 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error) {
+func resourceAllocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -517,6 +510,7 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 			prevComment, _ := d.GetChange("comment")
 			prevDisable, _ := d.GetChange("disable")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("dns_view", prevDNSView.(string))
@@ -533,6 +527,7 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("disable", prevDisable.(bool))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
 
@@ -540,26 +535,13 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); ok {
 			d.SetId("")
-			return ibclient.NewNotFoundError(fmt.Sprintf(
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
 				"cannot find apropriate object on NIOS side for resource with ID '%s': %s;"+
 					" removing the resource from Terraform state",
-				d.Id(), err))
+				d.Id(), err)))
 		}
 
-		return err
-	}
-
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
-	}
-	if d.HasChange("ip_address_type") {
-		return fmt.Errorf("changing the value of 'ip_address_type' field is not allowed")
+		return diag.FromErr(err)
 	}
 
 	enableDNS := d.Get("enable_dns").(bool)
@@ -571,16 +553,12 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 	for i, alias := range aliases {
 		aliasStrs[i] = alias.(string)
 	}
-	if d.HasChange("dns_view") && !d.HasChange("enable_dns") {
-		return fmt.Errorf(
-			"changing the value of 'dns_view' field is allowed only for the case of changing 'enable_dns' option")
-	}
 	if enableDNS {
 		if dnsView == disabledDNSView {
-			return fmt.Errorf("a valid DNS view's name MUST be defined ('dns_view' property) once 'enable_dns' has been changed from 'false' to 'true'")
+			return diag.FromErr(fmt.Errorf("a valid DNS view's name MUST be defined ('dns_view' property) once 'enable_dns' has been changed from 'false' to 'true'"))
 		}
 		if !strings.ContainsRune(fqdn, '.') {
-			return fmt.Errorf("'fqdn' value must be an FQDN without a trailing dot")
+			return diag.FromErr(fmt.Errorf("'fqdn' value must be an FQDN without a trailing dot"))
 		}
 
 	}
@@ -613,22 +591,15 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 	disable := d.Get("disable").(bool)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -694,12 +665,12 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 
 	hr, err := objMgr.GetHostRecordByRef(hostRecObj.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to update IP allocation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to update IP allocation: %w", err))
 	}
 
 	mergedEAs, err := mergeEAs(hr.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	hostRecObj, err = objMgr.UpdateHostRecord(
@@ -717,27 +688,27 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 		mergedEAs,
 		aliasStrs, disable)
 	if err != nil {
-		return fmt.Errorf(
-			"error while updating the host record with ID '%s': %s", d.Id(), err.Error())
+		return diag.FromErr(fmt.Errorf(
+			"error while updating the host record with ID '%s': %s", d.Id(), err.Error()))
 	}
 	updateSuccessful = true
 	if err = d.Set("ref", hostRecObj.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("dns_view", hostRecObj.View); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("fqdn", hostRecObj.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hostRecObj.Ipv6Addrs == nil || len(hostRecObj.Ipv6Addrs) < 1 {
 		if err := d.Set("allocated_ipv6_addr", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv6_addr", hostRecObj.Ipv6Addrs[0].Ipv6Addr); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	alias := hostRecObj.Aliases
@@ -747,33 +718,26 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 	}
 
 	if err = d.Set("aliases", aliasesInterface); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hostRecObj.Ipv4Addrs == nil || len(hostRecObj.Ipv4Addrs) < 1 {
 		if err := d.Set("allocated_ipv4_addr", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("allocated_ipv4_addr", hostRecObj.Ipv4Addrs[0].Ipv4Addr); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceAllocationRelease(d *schema.ResourceData, m interface{}) error {
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
-	}
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceAllocationRelease(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return fmt.Errorf("failed to delete network container: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to delete network container: %w", err))
 	}
 
 	var tenantID string
@@ -784,7 +748,7 @@ func resourceAllocationRelease(d *schema.ResourceData, m interface{}) error {
 	hostRec, err := getOrFindHostRec(d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return fmt.Errorf("cannot retrieve existing record from NIOS server for the resource ID %q: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("cannot retrieve existing record from NIOS server for the resource ID %q: %s", d.Id(), err))
 		}
 
 		// The resource seems to be deleted already,
@@ -802,14 +766,14 @@ func resourceAllocationRelease(d *schema.ResourceData, m interface{}) error {
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 	_, err = objMgr.DeleteHostRecord(hostRec.Ref)
 	if err != nil {
-		return fmt.Errorf("error while releasing the resource with ID '%s': %s", d.Id(), err.Error())
+		return diag.FromErr(fmt.Errorf("error while releasing the resource with ID '%s': %s", d.Id(), err.Error()))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func ipAllocationImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ipAllocationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
 	internalId := newInternalResourceIdFromString(d.Id())
 	if internalId == nil {
@@ -854,23 +818,15 @@ func ipAllocationImporter(d *schema.ResourceData, m interface{}) ([]*schema.Reso
 		}
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err = terraformDeserializeEAs(extAttrJSON)
+	_, err = terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
 
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	alias := obj.Aliases
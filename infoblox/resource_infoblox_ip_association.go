@@ -1,9 +1,11 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 
@@ -11,9 +13,9 @@ import (
 )
 
 func resourceIpAssociation() *schema.Resource {
-	return &schema.Resource{
+	return withImmutableFields(&schema.Resource{
 		Importer: &schema.ResourceImporter{
-			State: ipAssociationImporter,
+			StateContext: ipAssociationImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,20 +46,15 @@ func resourceIpAssociation() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}, "internal_id")
 }
 
 // TODO: add validation of values (extra spaces, format, etc)
-func resourceIpAssociationUpdate(d *schema.ResourceData, m interface{}) error {
-	if d.HasChange("internal_id") {
-		restoreIpAssociationState(d)
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-
-	return resourceIpAssociationCreateUpdate(d, m)
+func resourceIpAssociationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceIpAssociationCreateUpdate(ctx, d, m)
 }
 
-func resourceIpAssociationRead(d *schema.ResourceData, m interface{}) error {
+func resourceIpAssociationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var (
 		err                       error
 		hostRec                   *ibclient.HostRecord
@@ -74,12 +71,12 @@ func resourceIpAssociationRead(d *schema.ResourceData, m interface{}) error {
 			return nil
 		}
 
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hostRec.Ipv6Addrs != nil && len(hostRec.Ipv6Addrs) > 0 {
 		if len(hostRec.Ipv6Addrs) > 1 {
-			return fmt.Errorf("association with multiple IP addresses are not supported")
+			return diag.FromErr(fmt.Errorf("association with multiple IP addresses are not supported"))
 		}
 
 		enableDhcpActualIpv6 = *hostRec.Ipv6Addrs[0].EnableDhcp
@@ -92,7 +89,7 @@ func resourceIpAssociationRead(d *schema.ResourceData, m interface{}) error {
 
 	if hostRec.Ipv4Addrs != nil && len(hostRec.Ipv4Addrs) > 0 {
 		if len(hostRec.Ipv4Addrs) > 1 {
-			return fmt.Errorf("association with multiple IP addresses are not supported")
+			return diag.FromErr(fmt.Errorf("association with multiple IP addresses are not supported"))
 		}
 
 		enableDhcpActualIpv4 = *hostRec.Ipv4Addrs[0].EnableDhcp
@@ -106,26 +103,26 @@ func resourceIpAssociationRead(d *schema.ResourceData, m interface{}) error {
 	enableDhcpActual = enableDhcpActualIpv4 || enableDhcpActualIpv6
 
 	if err = d.Set("ref", hostRec.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("duid", duidActual); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("mac_addr", macAddrActual); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("enable_dhcp", enableDhcpActual); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIpAssociationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIpAssociationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// TODO: process carefully the case: the host record is already deleted
 	if err := resourceIpAssociationCreateUpdateCommon(d, m, "00:00:00:00:00:00", ""); err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return fmt.Errorf("error getting the allocated host record with ID '%s': %s", d.Id(), err.Error())
+			return diag.FromErr(fmt.Errorf("error getting the allocated host record with ID '%s': %s", d.Id(), err.Error()))
 		}
 
 		log.Warnf(
@@ -138,7 +135,7 @@ func resourceIpAssociationDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceIpAssociationCreateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIpAssociationCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var mac, duid string
 
 	val, ok := d.GetOk("mac_addr")
@@ -150,7 +147,7 @@ func resourceIpAssociationCreateUpdate(d *schema.ResourceData, m interface{}) er
 		duid = val.(string)
 	}
 
-	return resourceIpAssociationCreateUpdateCommon(d, m, mac, duid)
+	return diag.FromErr(resourceIpAssociationCreateUpdateCommon(d, m, mac, duid))
 }
 
 func restoreIpAssociationState(d *schema.ResourceData) {
@@ -283,15 +280,15 @@ func resourceIpAssociationCreateUpdateCommon(
 
 func resourceIpAssociationInit() *schema.Resource {
 	association := resourceIpAssociation()
-	association.Create = resourceIpAssociationCreateUpdate
-	association.Read = resourceIpAssociationRead
-	association.Update = resourceIpAssociationUpdate
-	association.Delete = resourceIpAssociationDelete
+	association.CreateContext = resourceIpAssociationCreateUpdate
+	association.ReadContext = resourceIpAssociationRead
+	association.UpdateContext = resourceIpAssociationUpdate
+	association.DeleteContext = resourceIpAssociationDelete
 
 	return association
 }
 
-func ipAssociationImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ipAssociationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	internalId := newInternalResourceIdFromString(d.Id())
 	if internalId == nil {
 		return nil, fmt.Errorf("ID value provided is not in a proper format")
@@ -4,19 +4,27 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"reflect"
 )
 
+// rangeNaturalKey maps the arguments identifying the IPv4 ranges to the WAPI search fields.
+var rangeNaturalKey = map[string]string{
+	"start_addr":   "start_addr",
+	"end_addr":     "end_addr",
+	"network_view": "network_view",
+}
+
 func resourceRange() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceRangeCreate,
-		Read:   resourceRangeRead,
-		Update: resourceRangeUpdate,
-		Delete: resourceRangeDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withInheritableExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceRangeCreate,
+		ReadContext:   resourceRangeRead,
+		UpdateContext: resourceRangeUpdate,
+		DeleteContext: resourceRangeDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceRangeImport,
+			StateContext: resourceRangeImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -216,13 +224,13 @@ func resourceRange() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "network_view"), "range", rangeNaturalKey), rangeNaturalKey)
 }
 
-func resourceRangeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	comment := d.Get("comment").(string)
 	name := d.Get("name").(string)
@@ -234,12 +242,11 @@ func resourceRangeCreate(d *schema.ResourceData, m interface{}) error {
 	startAddr := d.Get("start_addr").(string)
 	endAddr := d.Get("end_addr").(string)
 	disable := d.Get("disable").(bool)
-	extAttrJSON := d.Get("ext_attrs").(string)
 	useOptions := d.Get("use_options").(bool)
 	optionsInterface := d.Get("options").([]interface{})
 	options, err := validateDhcpOptions(optionsInterface)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	serverAssociationType := d.Get("server_association_type").(string)
 	failOverAssociation := d.Get("failover_association").(string)
@@ -248,11 +255,11 @@ func resourceRangeCreate(d *schema.ResourceData, m interface{}) error {
 	member := d.Get("member").(map[string]interface{})
 	dhcpMember, err := ConvertMapToDhcpMember(member)
 	if err != nil {
-		return fmt.Errorf("failed to convert member to dhcpmember: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to convert member to dhcpmember: %w", err))
 	}
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -264,34 +271,31 @@ func resourceRangeCreate(d *schema.ResourceData, m interface{}) error {
 		tenantID = tempVal.(string)
 	}
 
-	connector := m.(ibclient.IBConnector)
+	connector, err := connectorWithEAInheritance(m.(ibclient.IBConnector), d, m, "range", extAttrs, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 	newNetworkRange, err := objMgr.CreateNetworkRange(comment, name, network, networkView, startAddr, endAddr, disable, extAttrs, dhcpMember, failOverAssociation, options, useOptions, serverAssociationType, template, msServer)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(newNetworkRange.Ref)
 	if err = d.Set("ref", newNetworkRange.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceRangeRead(d, m)
+	return resourceRangeRead(ctx, d, m)
 
 }
-func resourceRangeRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
-
+func resourceRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rec, err := searchObjectByRefOrInternalId("Range", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -300,81 +304,77 @@ func resourceRangeRead(d *schema.ResourceData, m interface{}) error {
 	var networkRange *ibclient.Range
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal network range: %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal network range: %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &networkRange)
 	if err != nil {
-		return fmt.Errorf("failed getting network range : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting network range : %s", err.Error()))
 	}
 
 	delete(networkRange.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(networkRange.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	inheritedEAs, err := readInheritedEAs(d, m, networkRange.Ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = terraformSetInheritableEAs(d, m, networkRange.Ea, inheritedEAs); err != nil {
+		return diag.FromErr(err)
 	}
 	// Assertion of object type and error handling
 	if err = d.Set("comment", networkRange.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("name", networkRange.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("network", networkRange.Network); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("network_view", networkRange.NetworkView); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("start_addr", networkRange.StartAddr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("end_addr", networkRange.EndAddr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("disable", networkRange.Disable); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("failover_association", networkRange.FailoverAssociation); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if networkRange.MsServer != nil {
 		if err = d.Set("ms_server", networkRange.MsServer.Ipv4Addr); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err = d.Set("ms_server", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if networkRange.Member != nil {
 		member := convertDhcpMemberToMap(networkRange.Member)
 		if err = d.Set("member", member); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err = d.Set("server_association_type", networkRange.ServerAssociationType); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("options", convertDhcpOptionsToInterface(networkRange.Options)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("use_options", networkRange.UseOptions); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("template", networkRange.Template); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(networkRange.Ref)
 	return nil
 }
-func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceRangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -388,6 +388,7 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 			prevStartAddr, _ := d.GetChange("start_addr")
 			prevEndAddr, _ := d.GetChange("end_addr")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 			prevMember, _ := d.GetChange("member")
 			prevDisable, _ := d.GetChange("disable")
 			prevOptions, _ := d.GetChange("options")
@@ -405,6 +406,7 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("end_addr", prevEndAddr.(string))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 			_ = d.Set("network_view", prevNetworkView.(string))
 			_ = d.Set("member", prevMember)
 			_ = d.Set("disable", prevDisable.(bool))
@@ -416,12 +418,6 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ms_server", prevMsServer.(string))
 		}
 	}()
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
 	comment := d.Get("comment").(string)
 	name := d.Get("name").(string)
 	network := d.Get("network").(string)
@@ -433,24 +429,17 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	member := d.Get("member").(map[string]interface{})
 	dhcpMember, err := ConvertMapToDhcpMember(member)
 	if err != nil {
-		return fmt.Errorf("failed to convert member to dhcpmember: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to convert member to dhcpmember: %w", err))
 	}
 	networkView := d.Get("network_view").(string)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	failoverAssociation := d.Get("failover_association").(string)
 	serverAssociationType := d.Get("server_association_type").(string)
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -459,28 +448,19 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	connector := m.(ibclient.IBConnector)
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
-
-	var networkRange *ibclient.Range
 
-	rec, err := searchObjectByRefOrInternalId("Range", d, m)
+	// The EAs are read along with their inheritance, to keep inheriting the EAs not managed by the resource.
+	networkRange := ibclient.NewEmptyRange()
+	inheritedEAs, err := getObjectWithInheritedEAs(connector, networkRange, d.Id())
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
 		}
 	}
-	recJson, err := json.Marshal(rec)
-	if err != nil {
-		return fmt.Errorf("failed to marshal Network Range : %s", err.Error())
-	}
-	err = json.Unmarshal(recJson, &networkRange)
-	if err != nil {
-		return fmt.Errorf("failed getting Network Range : %s", err.Error())
-	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
 	internalId := d.Get("internal_id").(string)
@@ -491,6 +471,14 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	newExtAttrs[eaNameForInternalId] = newInternalId.String()
 
 	newExtAttrs, err = mergeEAs(networkRange.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	connector, err = connectorWithEAInheritance(connector, d, m, "range", newExtAttrs, inheritedEAs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	// Check if the options field has changes
 	oldOptions, newOptions := d.GetChange("options")
@@ -498,37 +486,36 @@ func resourceRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	newList, okNew := newOptions.([]interface{})
 
 	if !okOld || !okNew {
-		return fmt.Errorf("options is not a slice of interfaces")
+		return diag.FromErr(fmt.Errorf("options is not a slice of interfaces"))
 	}
 
 	optimizedOptions := optimizeDhcpOptions(oldList, newList)
 	options, err := validateDhcpOptions(optimizedOptions)
 	if err != nil {
-		return fmt.Errorf("failed to validate options: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to validate options: %w", err))
 	}
 
 	networkRange, err = objMgr.UpdateNetworkRange(d.Id(), comment, name, network, startAddr, endAddr, disable, newExtAttrs, dhcpMember, failoverAssociation, options, useOptions, serverAssociationType, networkView, msServer)
 	if err != nil {
-		return fmt.Errorf("Failed to update network range with %s, ", err.Error())
+		return diag.FromErr(fmt.Errorf("Failed to update network range with %s, ", err.Error()))
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", networkRange.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(networkRange.Ref)
-	return resourceRangeRead(d, m)
+	return resourceRangeRead(ctx, d, m)
 
 }
-func resourceRangeDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -543,8 +530,8 @@ func resourceRangeDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("Range", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -554,23 +541,22 @@ func resourceRangeDelete(d *schema.ResourceData, m interface{}) error {
 	var networkRange *ibclient.Range
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal network range : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal network range : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &networkRange)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = objMgr.DeleteNetworkRange(networkRange.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete network range : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to delete network range : %s", err.Error()))
 	}
 
 	return nil
 
 }
-func resourceRangeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceRangeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -583,14 +569,11 @@ func resourceRangeImport(d *schema.ResourceData, m interface{}) ([]*schema.Resou
 		return nil, fmt.Errorf("failed getting network range : %w", err)
 	}
 
-	if networkRange.Ea != nil && len(networkRange.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(networkRange.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = removeInheritedEAs(m, networkRange.Ref, networkRange.Ea); err != nil {
+		return nil, err
+	}
+	if err = terraformImportEAs(d, networkRange.Ea); err != nil {
+		return nil, err
 	}
 	delete(networkRange.Ea, eaNameForInternalId)
 	if networkRange.Comment != nil {
@@ -669,9 +652,8 @@ func resourceRangeImport(d *schema.ResourceData, m interface{}) ([]*schema.Resou
 	d.SetId(networkRange.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceRangeUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceRangeUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 
@@ -4,19 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"reflect"
 )
 
 func resourceRangeTemplate() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceRangeTemplateCreate,
-		Read:   resourceRangeTemplateRead,
-		Update: resourceRangeTemplateUpdate,
-		Delete: resourceRangeTemplateDelete,
+	return withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceRangeTemplateCreate,
+		ReadContext:   resourceRangeTemplateRead,
+		UpdateContext: resourceRangeTemplateUpdate,
+		DeleteContext: resourceRangeTemplateDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceRangeTemplateImport,
+			StateContext: resourceRangeTemplateImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -200,13 +201,13 @@ func resourceRangeTemplate() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	})
 }
 
-func resourceRangeTemplateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceRangeTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	name := d.Get("name").(string)
 	numberOfAddresses := d.Get("number_of_addresses").(int)
@@ -216,7 +217,7 @@ func resourceRangeTemplateCreate(d *schema.ResourceData, m interface{}) error {
 	options := d.Get("options").([]interface{})
 	optionsList, err := validateDhcpOptions(options)
 	if err != nil {
-		return fmt.Errorf("failed to validate options: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to validate options: %w", err))
 	}
 
 	serverAssociationType := d.Get("server_association_type").(string)
@@ -226,12 +227,11 @@ func resourceRangeTemplateCreate(d *schema.ResourceData, m interface{}) error {
 	msServer := d.Get("ms_server").(string)
 	dhcpMemeber, err := ConvertMapToDhcpMember(member)
 	if err != nil {
-		return fmt.Errorf("failed to convert member to dhcpmember: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to convert member to dhcpmember: %w", err))
 	}
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return fmt.Errorf("failed to allocate IP: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to allocate IP: %w", err))
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -247,121 +247,108 @@ func resourceRangeTemplateCreate(d *schema.ResourceData, m interface{}) error {
 	// Create the Range Template record
 	newRecord, err := objMgr.CreateRangeTemplate(name, uint32(numberOfAddresses), uint32(offset), comment, extAttrs, optionsList, useOptions, serverAssociationType, failoverAssociation, dhcpMemeber, cloudApiCompatible, msServer)
 	if err != nil {
-		return fmt.Errorf("failed to create Range Template record: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to create Range Template record: %w", err))
 	}
 	d.SetId(newRecord.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceRangeTemplateRead(d, m)
+	return resourceRangeTemplateRead(ctx, d, m)
 }
 
-func resourceRangeTemplateRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
+func resourceRangeTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rec, err := searchObjectByRefOrInternalId("RangeTemplate", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); ok {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
 	var rangeTemplate *ibclient.Rangetemplate
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Range Template record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal Range Template record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &rangeTemplate)
 	if err != nil {
-		return fmt.Errorf("failed getting Range Template record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting Range Template record : %s", err.Error()))
 	}
 
 	delete(rangeTemplate.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(rangeTemplate.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, rangeTemplate.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 	if rangeTemplate.Name != nil {
 		if err = d.Set("name", *rangeTemplate.Name); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if rangeTemplate.Comment != nil {
 		if err = d.Set("comment", *rangeTemplate.Comment); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if rangeTemplate.NumberOfAddresses != nil {
 		if err = d.Set("number_of_addresses", *rangeTemplate.NumberOfAddresses); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if rangeTemplate.Offset != nil {
 		if err = d.Set("offset", *rangeTemplate.Offset); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if rangeTemplate.UseOptions != nil {
 		if err = d.Set("use_options", rangeTemplate.UseOptions); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if rangeTemplate.Options != nil {
 		options := convertDhcpOptionsToInterface(rangeTemplate.Options)
 		if err = d.Set("options", options); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if rangeTemplate.ServerAssociationType != "" {
 		if err = d.Set("server_association_type", rangeTemplate.ServerAssociationType); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if rangeTemplate.FailoverAssociation != nil {
 		if err = d.Set("failover_association", *rangeTemplate.FailoverAssociation); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if rangeTemplate.Member != nil {
 		member := convertDhcpMemberToMap(rangeTemplate.Member)
 		if err = d.Set("member", member); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err = d.Set("cloud_api_compatible", rangeTemplate.CloudApiCompatible); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if rangeTemplate.MsServer != nil {
 		if err = d.Set("ms_server", rangeTemplate.MsServer.Ipv4Addr); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err = d.Set("ref", rangeTemplate.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(rangeTemplate.Ref)
 	return nil
 }
 
-func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceRangeTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -375,6 +362,7 @@ func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
 			prevFailoverAssociation, _ := d.GetChange("failover_association")
 			prevMember, _ := d.GetChange("member")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 			prevCloudApiCompatible, _ := d.GetChange("cloud_api_compatible")
 			prevMsServer, _ := d.GetChange("ms_server")
 
@@ -388,20 +376,15 @@ func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("failover_association", prevFailoverAssociation.(string))
 			_ = d.Set("member", prevMember.(map[string]interface{}))
 			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 			_ = d.Set("cloud_api_compatible", prevCloudApiCompatible.(bool))
 			_ = d.Set("ms_server", prevMsServer.(string))
 		}
 	}()
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var tenantID string
 	if tempVal, found := newExtAttrs[eaNameForTenantId]; found {
@@ -420,7 +403,7 @@ func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
 	member := d.Get("member").(map[string]interface{})
 	dhcpMemeber, err := ConvertMapToDhcpMember(member)
 	if err != nil {
-		return fmt.Errorf("failed to convert member to dhcpmember: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to convert member to dhcpmember: %w", err))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -430,8 +413,8 @@ func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("RangeTemplate", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -439,11 +422,11 @@ func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Range Template record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal Range Template record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &rangeTemplate)
 	if err != nil {
-		return fmt.Errorf("failed getting Range Template record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting Range Template record : %s", err.Error()))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -455,7 +438,7 @@ func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
 	newExtAttrs[eaNameForInternalId] = newInternalId.String()
 	newExtAttrs, err = mergeEAs(rangeTemplate.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Check if the options field has changes
@@ -464,36 +447,35 @@ func resourceRangeTemplateUpdate(d *schema.ResourceData, m interface{}) error {
 	newList, okNew := newOptions.([]interface{})
 
 	if !okOld || !okNew {
-		return fmt.Errorf("options is not a slice of interfaces")
+		return diag.FromErr(fmt.Errorf("options is not a slice of interfaces"))
 	}
 
 	optimizedOptions := optimizeDhcpOptions(oldList, newList)
 	options, err := validateDhcpOptions(optimizedOptions)
 	if err != nil {
-		return fmt.Errorf("failed to validate options: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to validate options: %w", err))
 	}
 
 	rangeTemplate, err = objMgr.UpdateRangeTemplate(d.Id(), name, uint32(numberOfAddresses), uint32(offset), comment, newExtAttrs, options, useOptions, serverAssociationType, failoverAssociation, dhcpMemeber, cloudApiCompatible, msServer)
 	if err != nil {
-		return fmt.Errorf("failed to update Range Template: %s.", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to update Range Template: %s.", err.Error()))
 	}
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", rangeTemplate.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(rangeTemplate.Ref)
-	return resourceRangeTemplateRead(d, m)
+	return resourceRangeTemplateRead(ctx, d, m)
 }
 
-func resourceRangeTemplateDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceRangeTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -507,8 +489,8 @@ func resourceRangeTemplateDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("RangeTemplate", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -518,22 +500,21 @@ func resourceRangeTemplateDelete(d *schema.ResourceData, m interface{}) error {
 	var rangeTemplate *ibclient.Rangetemplate
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Range Template record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal Range Template record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &rangeTemplate)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = objMgr.DeleteRangeTemplate(rangeTemplate.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete Range Template : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to delete Range Template : %s", err.Error()))
 	}
 	return nil
 }
 
-func resourceRangeTemplateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceRangeTemplateImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -546,14 +527,8 @@ func resourceRangeTemplateImport(d *schema.ResourceData, m interface{}) ([]*sche
 		return nil, fmt.Errorf("failed getting Range Template record: %w", err)
 	}
 
-	if rangeTemplate.Ea != nil && len(rangeTemplate.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(rangeTemplate.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, rangeTemplate.Ea); err != nil {
+		return nil, err
 	}
 	delete(rangeTemplate.Ea, eaNameForInternalId)
 
@@ -616,9 +591,8 @@ func resourceRangeTemplateImport(d *schema.ResourceData, m interface{}) ([]*sche
 	d.SetId(rangeTemplate.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceRangeTemplateUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceRangeTemplateUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
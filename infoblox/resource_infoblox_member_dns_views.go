@@ -0,0 +1,121 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+var memberDnsRegExp = regexp.MustCompile("^member:dns/.+")
+
+// resourceMemberDNSViews manages the order of the DNS views on a grid member, which determines
+// the view a DNS query is served from, when the query matches several views.
+func resourceMemberDNSViews() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMemberDNSViewsCreate,
+		ReadContext:   resourceMemberDNSViewsRead,
+		UpdateContext: resourceMemberDNSViewsUpdate,
+		DeleteContext: resourceMemberDNSViewsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"member": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The host name of the grid member.",
+			},
+			"views": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the DNS views served by the member, in the order of their precedence. " +
+					"All the views served by the member must be listed.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func resourceMemberDNSViewsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+	member := d.Get("member").(string)
+
+	var res []ibclient.MemberDns
+	qp := ibclient.NewQueryParams(false, map[string]string{"host_name": member})
+	if err := conn.GetObject(&ibclient.MemberDns{}, "", qp, &res); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(fmt.Errorf("failed to find DNS properties of member '%s': %w", member, err))
+	}
+	if len(res) == 0 {
+		return diag.FromErr(fmt.Errorf("member '%s' not found", member))
+	}
+	d.SetId(res[0].Ref)
+
+	return resourceMemberDNSViewsUpdate(ctx, d, m)
+}
+
+func resourceMemberDNSViewsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !memberDnsRegExp.MatchString(d.Id()) {
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'member:dns' object has an invalid format", d.Id()))
+	}
+
+	conn := m.(ibclient.IBConnector)
+
+	obj := &ibclient.MemberDns{}
+	obj.SetReturnFields([]string{"host_name", "views"})
+	var res ibclient.MemberDns
+	if err := conn.GetObject(obj, d.Id(), nil, &res); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read DNS properties of member '%s': %w", d.Id(), err))
+	}
+
+	if err := d.Set("member", res.HostName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("views", res.Views); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ref", res.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(res.Ref)
+
+	return nil
+}
+
+func resourceMemberDNSViewsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	obj := newWapiRawObject("member:dns", map[string]interface{}{
+		"views": expandStringList(d.Get("views").([]interface{})),
+	})
+	ref, err := conn.UpdateObject(obj, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update the order of DNS views of member '%s': %w",
+			d.Get("member").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceMemberDNSViewsRead(ctx, d, m)
+}
+
+// resourceMemberDNSViewsDelete only removes the resource from the state:
+// the member keeps serving the views in the last specified order.
+func resourceMemberDNSViewsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
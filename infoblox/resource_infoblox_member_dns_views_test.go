@@ -0,0 +1,44 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAcc_resourceMemberDNSViews(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_dns_view" "internal" {
+						name = "tf_acc_test_internal"
+					}
+					resource "infoblox_member_dns_views" "member_views" {
+						member = "infoblox.localdomain"
+						views = [infoblox_dns_view.internal.name, "default"]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_member_dns_views.member_views", "views.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_member_dns_views.member_views", "views.0", "tf_acc_test_internal"),
+					resource.TestCheckResourceAttr("infoblox_member_dns_views.member_views", "views.1", "default"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_dns_view" "internal" {
+						name = "tf_acc_test_internal"
+					}
+					resource "infoblox_member_dns_views" "member_views" {
+						member = "infoblox.localdomain"
+						views = ["default", infoblox_dns_view.internal.name]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_member_dns_views.member_views", "views.0", "default"),
+					resource.TestCheckResourceAttr("infoblox_member_dns_views.member_views", "views.1", "tf_acc_test_internal"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,238 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"strings"
+)
+
+const (
+	memberServiceDNS  = "dns"
+	memberServiceDHCP = "dhcp"
+)
+
+// memberServiceStatus is the state of a service on a grid member.
+type memberServiceStatus struct {
+	Ref      string
+	HostName string
+	Enabled  bool
+}
+
+func resourceMemberService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMemberServiceCreate,
+		ReadContext:   resourceMemberServiceRead,
+		UpdateContext: resourceMemberServiceUpdate,
+		DeleteContext: resourceMemberServiceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMemberServiceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"member": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The host name of the grid member.",
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{memberServiceDNS, memberServiceDHCP}, false),
+				Description:  "The service to manage on the member: dns or dhcp.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether the service is running on the member.",
+			},
+			"original_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "The state of the service on the member before it was managed by the resource; " +
+					"restored when the resource is destroyed.",
+			},
+		},
+	}
+}
+
+// getMemberServiceStatuses returns the state of the service on all the grid members.
+func getMemberServiceStatuses(m interface{}, service string) ([]memberServiceStatus, error) {
+	objMgr := ibclient.NewObjectManager(m.(ibclient.IBConnector), "Terraform", "")
+
+	var res []memberServiceStatus
+	switch service {
+	case memberServiceDNS:
+		members, err := objMgr.GetDnsMember("")
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			res = append(res, memberServiceStatus{Ref: member.Ref, HostName: member.HostName, Enabled: member.EnableDns})
+		}
+	case memberServiceDHCP:
+		members, err := objMgr.GetDhcpMember("")
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			res = append(res, memberServiceStatus{Ref: member.Ref, HostName: member.HostName, Enabled: member.EnableDhcp})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported service '%s'", service)
+	}
+
+	return res, nil
+}
+
+// getMemberServiceStatus returns the state of the service on the member with the given reference or host name.
+func getMemberServiceStatus(m interface{}, service, ref, hostName string) (*memberServiceStatus, error) {
+	statuses, err := getMemberServiceStatuses(m, service)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range statuses {
+		if ref != "" && s.Ref == ref || ref == "" && s.HostName == hostName {
+			return &s, nil
+		}
+	}
+
+	return nil, ibclient.NewNotFoundError(fmt.Sprintf("%s service of member '%s' not found", service, hostName))
+}
+
+// setMemberServiceStatus starts or stops the service on the member with the given reference.
+// Only the 'enable_' flag is sent: the go-client's objects would send empty extensible attributes as well,
+// removing the extensible attributes of the member.
+func setMemberServiceStatus(m interface{}, service, ref string, enabled bool) (string, error) {
+	var obj *wapiRawObject
+	switch service {
+	case memberServiceDNS:
+		obj = newWapiRawObject("member:dns", map[string]interface{}{"enable_dns": enabled})
+	case memberServiceDHCP:
+		obj = newWapiRawObject("member:dhcpproperties", map[string]interface{}{"enable_dhcp": enabled})
+	default:
+		return "", fmt.Errorf("unsupported service '%s'", service)
+	}
+
+	return m.(ibclient.IBConnector).UpdateObject(obj, ref)
+}
+
+func resourceMemberServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	service := d.Get("service").(string)
+	member := d.Get("member").(string)
+
+	status, err := getMemberServiceStatus(m, service, "", member)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read %s service status of member '%s': %w", service, member, err))
+	}
+	if err = d.Set("original_enabled", status.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(status.Ref)
+
+	enabled := d.Get("enabled").(bool)
+	if status.Enabled != enabled {
+		ref, err := setMemberServiceStatus(m, service, status.Ref, enabled)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update %s service status of member '%s': %w",
+				service, member, withWapiErrorAttribute(err, "enabled")))
+		}
+		d.SetId(ref)
+	}
+
+	return resourceMemberServiceRead(ctx, d, m)
+}
+
+func resourceMemberServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	service := d.Get("service").(string)
+
+	status, err := getMemberServiceStatus(m, service, d.Id(), d.Get("member").(string))
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read %s service status of member '%s': %w", service, d.Id(), err))
+	}
+
+	if err = d.Set("member", status.HostName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enabled", status.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(status.Ref)
+
+	return nil
+}
+
+func resourceMemberServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	service := d.Get("service").(string)
+
+	ref, err := setMemberServiceStatus(m, service, d.Id(), d.Get("enabled").(bool))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update %s service status of member '%s': %w",
+			service, d.Get("member").(string), withWapiErrorAttribute(err, "enabled")))
+	}
+	d.SetId(ref)
+
+	return resourceMemberServiceRead(ctx, d, m)
+}
+
+// resourceMemberServiceDelete restores the state of the service, which the member had
+// before the resource was created.
+func resourceMemberServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	service := d.Get("service").(string)
+	originalEnabled := d.Get("original_enabled").(bool)
+
+	status, err := getMemberServiceStatus(m, service, d.Id(), d.Get("member").(string))
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read %s service status of member '%s': %w", service, d.Id(), err))
+	}
+
+	if status.Enabled != originalEnabled {
+		if _, err = setMemberServiceStatus(m, service, status.Ref, originalEnabled); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to restore %s service status of member '%s': %w",
+				service, status.HostName, err))
+		}
+	}
+
+	return nil
+}
+
+// resourceMemberServiceImport imports the service of a member by the ID in the format '<service>/<member host name>'.
+// The current state of the service is considered as the original one.
+func resourceMemberServiceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("ID '%s' must be in the format '<service>/<member host name>'", d.Id())
+	}
+	service, member := parts[0], parts[1]
+
+	status, err := getMemberServiceStatus(m, service, "", member)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s service status of member '%s': %w", service, member, err)
+	}
+
+	if err = d.Set("service", service); err != nil {
+		return nil, err
+	}
+	if err = d.Set("member", status.HostName); err != nil {
+		return nil, err
+	}
+	if err = d.Set("original_enabled", status.Enabled); err != nil {
+		return nil, err
+	}
+	d.SetId(status.Ref)
+
+	return []*schema.ResourceData{d}, nil
+}
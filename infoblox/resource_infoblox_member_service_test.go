@@ -0,0 +1,85 @@
+package infoblox
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func testAccCheckMemberServiceEnabled(service, member string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		status, err := getMemberServiceStatus(testAccProvider.Meta(), service, "", member)
+		if err != nil {
+			return err
+		}
+		if status.Enabled != enabled {
+			return fmt.Errorf("%s service of member '%s' is expected to be enabled=%t", service, member, enabled)
+		}
+		return nil
+	}
+}
+
+func TestAcc_resourceMemberService(t *testing.T) {
+	var originalEnabled bool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			status, err := getMemberServiceStatus(testAccProvider.Meta(), memberServiceDHCP, "", "infoblox.localdomain")
+			if err != nil {
+				t.Fatal(err)
+			}
+			originalEnabled = status.Enabled
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckMemberServiceEnabled(memberServiceDHCP, "infoblox.localdomain", originalEnabled)(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_member_service" "dhcp" {
+						member = "infoblox.localdomain"
+						service = "dhcp"
+						enabled = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_member_service.dhcp", "enabled", "true"),
+					testAccCheckMemberServiceEnabled(memberServiceDHCP, "infoblox.localdomain", true),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_member_service" "dhcp" {
+						member = "infoblox.localdomain"
+						service = "dhcp"
+						enabled = false
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_member_service.dhcp", "enabled", "false"),
+					testAccCheckMemberServiceEnabled(memberServiceDHCP, "infoblox.localdomain", false),
+				),
+			},
+			{
+				ResourceName:            "infoblox_member_service.dhcp",
+				ImportState:             true,
+				ImportStateId:           "dhcp/infoblox.localdomain",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_enabled"},
+			},
+		},
+	})
+}
+
+func TestSetMemberServiceStatus(t *testing.T) {
+	conn := &testAdoptConnector{}
+	ref := "member:dns/ZG5zLm1lbWJlciQw:infoblox.localdomain"
+	if _, err := setMemberServiceStatus(conn, memberServiceDNS, ref, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The extensible attributes of the member must not be sent.
+	if expected := ref + ` {"enable_dns":true}`; len(conn.updates) != 1 || conn.updates[0] != expected {
+		t.Errorf("expected only the status of the service to be sent, got %v", conn.updates)
+	}
+}
@@ -0,0 +1,348 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+var (
+	msServerRegExp = regexp.MustCompile("^msserver/.+")
+
+	msServerReturnFields = []string{
+		"address", "grid_member", "managing_member", "login_name", "network_view", "dns_view", "comment", "disabled",
+		"read_only", "synchronization_min_delay", "dns_server", "dhcp_server", "server_name", "version", "ad_domain",
+		"connection_status", "synchronization_status", "extattrs"}
+)
+
+func resourceMsServer() *schema.Resource {
+	return withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceMsServerCreate,
+		ReadContext:   resourceMsServerRead,
+		UpdateContext: resourceMsServerUpdate,
+		DeleteContext: resourceMsServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMsServerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP address or FQDN of the Microsoft server.",
+			},
+			"grid_member": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The host name of the grid member managing the Microsoft server.",
+			},
+			"login_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The login name used to connect to the Microsoft server, optionally with the domain name.",
+			},
+			"login_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				WriteOnly: true,
+				Description: "The password used to connect to the Microsoft server. It is write-only: it is never stored " +
+					"in the state and never read back from NIOS. It is sent to NIOS on creation and whenever " +
+					"'login_password_version' changes.",
+			},
+			"login_password_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Change the value of the field to send the value of 'login_password' to NIOS on the update.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The network view the DHCP data of the Microsoft server is synchronized to.",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "The DNS view the DNS data of the Microsoft server is synchronized to.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the Microsoft server.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the synchronization with the Microsoft server is disabled.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the Microsoft server is managed in read-only mode.",
+			},
+			"manage_dns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the DNS service of the Microsoft server is managed by NIOS.",
+			},
+			"manage_dhcp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the DHCP service of the Microsoft server is managed by NIOS.",
+			},
+			"synchronization_min_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The minimum number of minutes between two synchronizations with the Microsoft server.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The Extensible attributes of the Microsoft server, as a map in JSON format",
+			},
+			"managing_member": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The host name of the grid member currently managing the Microsoft server.",
+			},
+			"server_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the Microsoft server, as reported by the server.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the Microsoft server.",
+			},
+			"ad_domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Active Directory domain the Microsoft server belongs to, if any.",
+			},
+			"connection_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The result of the last connection attempt to the Microsoft server.",
+			},
+			"synchronization_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The summary of the synchronization status of the Microsoft server.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	})
+}
+
+func expandMsServer(d *schema.ResourceData, extAttrs ibclient.EA, withPassword bool) (*wapiRawObject, error) {
+	fields := map[string]interface{}{
+		"address":      d.Get("address").(string),
+		"grid_member":  d.Get("grid_member").(string),
+		"login_name":   d.Get("login_name").(string),
+		"network_view": d.Get("network_view").(string),
+		"dns_view":     d.Get("dns_view").(string),
+		"comment":      d.Get("comment").(string),
+		"disabled":     d.Get("disabled").(bool),
+		"read_only":    d.Get("read_only").(bool),
+		"dns_server":   map[string]interface{}{"managed": d.Get("manage_dns").(bool)},
+		"dhcp_server":  map[string]interface{}{"managed": d.Get("manage_dhcp").(bool)},
+		"extattrs":     extAttrs,
+	}
+	if withPassword {
+		password, err := getWriteOnlyString(d, "login_password")
+		if err != nil {
+			return nil, err
+		}
+		fields["login_password"] = password
+	}
+	if delay, ok := d.GetOk("synchronization_min_delay"); ok {
+		fields["synchronization_min_delay"] = delay.(int)
+	}
+
+	return newWapiRawObject("msserver", fields), nil
+}
+
+func resourceMsServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	server, err := expandMsServer(d, extAttrs, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	conn := m.(ibclient.IBConnector)
+	ref, err := conn.CreateObject(server)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of Microsoft server '%s' failed: %w", d.Get("address").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceMsServerRead(ctx, d, m)
+}
+
+func getMsServer(conn ibclient.IBConnector, ref string) (*ibclient.Msserver, error) {
+	if !msServerRegExp.MatchString(ref) {
+		return nil, fmt.Errorf("reference '%s' for 'msserver' object has an invalid format", ref)
+	}
+
+	server := &ibclient.Msserver{}
+	server.SetReturnFields(msServerReturnFields)
+	var res ibclient.Msserver
+	if err := conn.GetObject(server, ref, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func resourceMsServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	server, err := getMsServer(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read Microsoft server '%s': %w", d.Id(), err))
+	}
+
+	for field, value := range flattenMsServer(*server) {
+		if field == "id" {
+			continue
+		}
+		if err = d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = terraformSetEAs(d, m, server.Ea); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ref", server.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(server.Ref)
+
+	return nil
+}
+
+func resourceMsServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cur, err := getMsServer(conn, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Microsoft server for update operation: %w", err))
+	}
+
+	extAttrs, err := mergeEAs(cur.Ea, newExtAttrs, oldExtAttrs, conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	server, err := expandMsServer(d, extAttrs, d.HasChange("login_password_version"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ref, err := conn.UpdateObject(server, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of Microsoft server '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourceMsServerRead(ctx, d, m)
+}
+
+func resourceMsServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of Microsoft server '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceMsServerImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	server, err := getMsServer(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Microsoft server: %w", err)
+	}
+
+	if err = terraformImportEAs(d, server.Ea); err != nil {
+		return nil, err
+	}
+	d.SetId(server.Ref)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenMsServer(server ibclient.Msserver) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":                     server.Ref,
+		"address":                "",
+		"grid_member":            "",
+		"login_name":             "",
+		"network_view":           "",
+		"dns_view":               "",
+		"comment":                "",
+		"disabled":               false,
+		"read_only":              false,
+		"manage_dns":             server.DnsServer != nil && server.DnsServer.Managed,
+		"manage_dhcp":            server.DhcpServer != nil && server.DhcpServer.Managed,
+		"managing_member":        server.ManagingMember,
+		"server_name":            server.ServerName,
+		"version":                server.Version,
+		"ad_domain":              server.AdDomain,
+		"connection_status":      server.ConnectionStatus,
+		"synchronization_status": server.SynchronizationStatus,
+	}
+	strFields := map[string]*string{
+		"address":      server.Address,
+		"grid_member":  server.GridMember,
+		"login_name":   server.LoginName,
+		"network_view": server.NetworkView,
+		"dns_view":     server.DnsView,
+		"comment":      server.Comment,
+	}
+	for field, value := range strFields {
+		if value != nil {
+			res[field] = *value
+		}
+	}
+	if server.Disabled != nil {
+		res["disabled"] = *server.Disabled
+	}
+	if server.ReadOnly != nil {
+		res["read_only"] = *server.ReadOnly
+	}
+	if server.SynchronizationMinDelay != nil {
+		res["synchronization_min_delay"] = int(*server.SynchronizationMinDelay)
+	}
+
+	return res
+}
@@ -0,0 +1,78 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"testing"
+)
+
+func testAccCheckMsServerDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_ms_server", func() ibclient.IBObject {
+		return &ibclient.Msserver{}
+	})(s)
+}
+
+func TestAcc_resourceMsServer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMsServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ms_server" "server" {
+						address = "10.41.0.10"
+						grid_member = "infoblox.localdomain"
+						login_name = "TFACC\\infoblox"
+						login_password = "Test-Passw0rd"
+						disabled = true
+						comment = "Microsoft server created by acceptance tests"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "address", "10.41.0.10"),
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "grid_member", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "login_name", "TFACC\\infoblox"),
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "network_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "manage_dns", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ms_server" "server" {
+						address = "10.41.0.10"
+						grid_member = "infoblox.localdomain"
+						login_name = "TFACC\\infoblox"
+						login_password = "Test-Passw0rd2"
+						login_password_version = 2
+						disabled = true
+						manage_dns = true
+						manage_dhcp = true
+						synchronization_min_delay = 5
+					}
+
+					data "infoblox_ms_server" "server" {
+						filters = {
+							address = infoblox_ms_server.server.address
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "manage_dns", "true"),
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "manage_dhcp", "true"),
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "synchronization_min_delay", "5"),
+					resource.TestCheckResourceAttr("infoblox_ms_server.server", "comment", ""),
+					resource.TestCheckResourceAttr("data.infoblox_ms_server.server", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ms_server.server", "results.0.manage_dhcp", "true"),
+					resource.TestCheckNoResourceAttr("data.infoblox_ms_server.server", "results.0.login_password"),
+					resource.TestCheckNoResourceAttr("infoblox_ms_server.server", "login_password"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_ms_server.server",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"login_password_version"},
+			},
+		},
+	})
+}
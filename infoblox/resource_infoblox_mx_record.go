@@ -4,19 +4,28 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+// mxRecordNaturalKey maps the arguments identifying the MX-records to the WAPI search fields.
+var mxRecordNaturalKey = map[string]string{
+	"fqdn":           "name",
+	"mail_exchanger": "mail_exchanger",
+	"preference":     "preference",
+	"dns_view":       "view",
+}
+
 func resourceMXRecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceMXRecordCreate,
-		Read:   resourceMXRecordGet,
-		Update: resourceMXRecordUpdate,
-		Delete: resourceMXRecordDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceMXRecordCreate,
+		ReadContext:   resourceMXRecordGet,
+		UpdateContext: resourceMXRecordUpdate,
+		DeleteContext: resourceMXRecordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceMXRecordImport,
+			StateContext: resourceMXRecordImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -81,29 +90,29 @@ func resourceMXRecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "dns_view"), "record:mx", mxRecordNaturalKey), mxRecordNaturalKey)
 }
 
-func resourceMXRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceMXRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	dnsView := d.Get("dns_view").(string)
 
 	fqdn := d.Get("fqdn").(string)
 	if fqdn == "" {
-		return fmt.Errorf("'fqdn' must not be empty")
+		return diag.FromErr(fmt.Errorf("'fqdn' must not be empty"))
 	}
 
 	mx := d.Get("mail_exchanger").(string)
 	if mx == "" {
-		return fmt.Errorf("'mail_exchanger' must not be empty")
+		return diag.FromErr(fmt.Errorf("'mail_exchanger' must not be empty"))
 	}
 
 	tempInt := d.Get("preference").(int)
 	if err := ibclient.CheckIntRange("preference", tempInt, 0, 65535); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	preference := uint32(tempInt)
 
@@ -114,15 +123,14 @@ func resourceMXRecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -140,27 +148,22 @@ func resourceMXRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	newRecord, err := objMgr.CreateMXRecord(dnsView, fqdn, mx, preference, ttl, useTtl, comment, extAttrs)
 	if err != nil {
-		return fmt.Errorf("error creating MX-record: %s", err)
+		return diag.FromErr(fmt.Errorf("error creating MX-record: %s", err))
 	}
 	d.SetId(newRecord.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 
 }
 
-func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceMXRecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
 
 	rec, err := searchObjectByRefOrInternalId("MX", d, m)
 	if err != nil {
@@ -168,8 +171,8 @@ func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
@@ -179,7 +182,7 @@ func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil {
-		return fmt.Errorf("failed getting MX-Record: %s", err)
+		return diag.FromErr(fmt.Errorf("failed getting MX-Record: %s", err))
 	}
 
 	if obj.Ttl != nil {
@@ -190,45 +193,37 @@ func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, obj.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("fqdn", obj.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("mail_exchanger", obj.MailExchanger); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("preference", obj.Preference); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(obj.Ref)
 
 	return nil
 }
 
-func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceMXRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -243,6 +238,7 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("dns_view", prevDNSView.(string))
 			_ = d.Set("fqdn", prevFQDN.(string))
@@ -251,21 +247,16 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
-	}
 	dnsView := d.Get("dns_view").(string)
 	fqdn := d.Get("fqdn").(string)
 	mx := d.Get("mail_exchanger").(string)
 
 	tempInt := d.Get("preference").(int)
 	if err := ibclient.CheckIntRange("preference", tempInt, 0, 65535); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	preference := uint32(tempInt)
 
@@ -277,21 +268,14 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -304,7 +288,7 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	mxrec, err := objMgr.GetMXRecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read MX Record for update operation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read MX Record for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -318,31 +302,30 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(mxrec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rec, err := objMgr.UpdateMXRecord(
 		d.Id(), dnsView, fqdn, mx, preference, ttl, useTtl, comment, newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("error updating MX-Record: %s", err)
+		return diag.FromErr(fmt.Errorf("error updating MX-Record: %s", err))
 	}
 	updateSuccessful = true
 	d.SetId(rec.Ref)
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", rec.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceMXRecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceMXRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -357,8 +340,8 @@ func resourceMXRecordDelete(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		} else {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		}
 	}
 
@@ -372,17 +355,16 @@ func resourceMXRecordDelete(d *schema.ResourceData, m interface{}) error {
 
 	_, err = objMgr.DeleteMXRecord(mxrec.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of MX-Record failed: %s", err)
+		return diag.FromErr(fmt.Errorf("deletion of MX-Record failed: %s", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceMXRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceMXRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -412,14 +394,8 @@ func resourceMXRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 		return nil, err
 	}
 
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
@@ -440,9 +416,8 @@ func resourceMXRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 	d.SetId(obj.Ref)
 
 	// Update the resource with EA Terraform Internal ID
-	err = resourceMXRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceMXRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
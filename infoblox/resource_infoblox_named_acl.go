@@ -0,0 +1,209 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+var (
+	namedACLRegExp = regexp.MustCompile("^namedacl/.+")
+
+	namedACLReturnFields = []string{"name", "comment", "access_list", "extattrs"}
+)
+
+func resourceNamedACL() *schema.Resource {
+	return withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceNamedACLCreate,
+		ReadContext:   resourceNamedACLRead,
+		UpdateContext: resourceNamedACLUpdate,
+		DeleteContext: resourceNamedACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNamedACLImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the named ACL.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the named ACL; maximum 256 characters.",
+			},
+			"access_list": accessControlListSchema(
+				"The ordered list of access control entries of the named ACL: IPv4/IPv6 addresses, networks, " +
+					"TSIG keys and other named ACLs, each with the ALLOW or DENY permission."),
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The Extensible attributes of the named ACL, as a map in JSON format",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	})
+}
+
+func expandNamedACL(d *schema.ResourceData, extAttrs ibclient.EA) (*wapiRawObject, error) {
+	accessList, err := expandAccessControlList("access_list", d.Get("access_list").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return newWapiRawObject("namedacl", map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"comment":     d.Get("comment").(string),
+		"access_list": accessList,
+		"extattrs":    extAttrs,
+	}), nil
+}
+
+func resourceNamedACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	acl, err := expandNamedACL(d, extAttrs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	conn := m.(ibclient.IBConnector)
+	ref, err := conn.CreateObject(acl)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of named ACL '%s' failed: %w", d.Get("name").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceNamedACLRead(ctx, d, m)
+}
+
+func getNamedACL(conn ibclient.IBConnector, ref string) (*ibclient.Namedacl, error) {
+	if !namedACLRegExp.MatchString(ref) {
+		return nil, fmt.Errorf("reference '%s' for 'namedacl' object has an invalid format", ref)
+	}
+
+	acl := &ibclient.Namedacl{}
+	acl.SetReturnFields(namedACLReturnFields)
+	var res ibclient.Namedacl
+	if err := conn.GetObject(acl, ref, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func resourceNamedACLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	acl, err := getNamedACL(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read named ACL '%s': %w", d.Id(), err))
+	}
+
+	for field, value := range flattenNamedACL(*acl) {
+		if field == "id" {
+			continue
+		}
+		if err = d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = terraformSetEAs(d, m, acl.Ea); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ref", acl.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(acl.Ref)
+
+	return nil
+}
+
+func resourceNamedACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cur, err := getNamedACL(conn, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read named ACL for update operation: %w", err))
+	}
+
+	extAttrs, err := mergeEAs(cur.Ea, newExtAttrs, oldExtAttrs, conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	acl, err := expandNamedACL(d, extAttrs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := conn.UpdateObject(acl, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of named ACL '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourceNamedACLRead(ctx, d, m)
+}
+
+func resourceNamedACLDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of named ACL '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceNamedACLImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	acl, err := getNamedACL(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read named ACL: %w", err)
+	}
+
+	if err = terraformImportEAs(d, acl.Ea); err != nil {
+		return nil, err
+	}
+	d.SetId(acl.Ref)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenNamedACL(acl ibclient.Namedacl) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":          acl.Ref,
+		"comment":     "",
+		"access_list": flattenAccessControlList(acl.AccessList),
+	}
+	if acl.Name != nil {
+		res["name"] = *acl.Name
+	}
+	if acl.Comment != nil {
+		res["comment"] = *acl.Comment
+	}
+
+	return res
+}
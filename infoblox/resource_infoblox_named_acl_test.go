@@ -0,0 +1,92 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"testing"
+)
+
+func testAccCheckNamedACLDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_named_acl", func() ibclient.IBObject {
+		return &ibclient.Namedacl{}
+	})(s)
+}
+
+func TestAcc_resourceNamedACL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNamedACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_named_acl" "internal" {
+						name = "tf_acc_test_internal_clients"
+						comment = "named ACL created by acceptance tests"
+						access_list {
+							address = "10.0.0.0/8"
+						}
+						access_list {
+							address = "10.1.0.0/16"
+							permission = "DENY"
+						}
+						ext_attrs = jsonencode({
+							"Site" = "Test site"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "name", "tf_acc_test_internal_clients"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.0.address", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.0.permission", "ALLOW"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.1.permission", "DENY"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "internal" {
+						name = "tf_acc_test_internal_clients"
+						access_list {
+							address = "10.1.0.0/16"
+							permission = "DENY"
+						}
+						access_list {
+							address = "10.0.0.0/8"
+						}
+					}
+					resource "infoblox_dns_view" "internal_view" {
+						name = "tf_acc_test_internal_view"
+						match_clients {
+							named_acl = infoblox_named_acl.internal.name
+						}
+					}
+					resource "infoblox_zone_auth" "internal_zone" {
+						fqdn = "tf-acc-test-named-acl.com"
+						view = infoblox_dns_view.internal_view.name
+						allow_query {
+							named_acl = infoblox_named_acl.internal.name
+						}
+						allow_transfer {
+							address = "Any"
+							permission = "DENY"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.0.address", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "comment", ""),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal_view", "match_clients.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal_view", "match_clients.0.named_acl", "tf_acc_test_internal_clients"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.internal_zone", "allow_query.0.named_acl", "tf_acc_test_internal_clients"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.internal_zone", "allow_transfer.0.address", "Any"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.internal_zone", "allow_update.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "infoblox_named_acl.internal",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
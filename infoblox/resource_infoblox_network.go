@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
@@ -27,10 +28,16 @@ var (
 	networkIPv6Regexp = regexp.MustCompile("^ipv6network/.+")
 )
 
+// networkNaturalKey maps the arguments identifying the networks to the WAPI search fields.
+var networkNaturalKey = map[string]string{
+	"cidr":         "network",
+	"network_view": "network_view",
+}
+
 func resourceNetwork() *schema.Resource {
-	return &schema.Resource{
+	return withImmutableFields(withInheritableExtensibleAttributes(&schema.Resource{
 		Importer: &schema.ResourceImporter{
-			State: resourceNetworkImport,
+			StateContext: resourceNetworkImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -123,6 +130,7 @@ func resourceNetwork() *schema.Resource {
 				Default:     "",
 				Description: "The Extensible attributes of the Network",
 			},
+			"vlans": vlanLinksSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -136,7 +144,7 @@ func resourceNetwork() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "network_view", "cidr", "reserve_ip", "reserve_ipv6", "gateway", "filter_params", "object")
 }
 
 func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
@@ -165,8 +173,7 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 
 	comment := d.Get("comment").(string)
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
 		return err
 	}
@@ -184,8 +191,15 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 		}
 	}
 
+	objType := "network"
+	if isIPv6 {
+		objType = "ipv6network"
+	}
 	ZeroMacAddr := "00:00:00:00:00:00"
-	connector := m.(ibclient.IBConnector)
+	connector, err := connectorWithEAInheritance(m.(ibclient.IBConnector), d, m, objType, extAttrs, nil)
+	if err != nil {
+		return err
+	}
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	var network *ibclient.Network
@@ -238,6 +252,12 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 		return err
 	}
 
+	if vlans := expandVlanLinks(d.Get("vlans").([]interface{})); len(vlans) > 0 {
+		if _, err = setNetworkVlanLinks(connector, network.Ref, vlans); err != nil {
+			return fmt.Errorf("assignment of VLANs to network block '%s' failed: %w", network.Cidr, withWapiErrorAttribute(err, "vlans"))
+		}
+	}
+
 	autoAllocateGateway := gateway == ""
 
 	if !autoAllocateGateway && gateway != "none" {
@@ -286,8 +306,7 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 	//networkViewName := d.Get("network_view").(string)
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return err
 	}
@@ -327,17 +346,12 @@ func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 	}
 	delete(extAttrs, eaNameForInternalId)
 
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	inheritedEAs, err := readInheritedEAs(d, m, obj.Ref)
+	if err != nil {
+		return err
+	}
+	if err = terraformSetInheritableEAs(d, m, obj.Ea, inheritedEAs); err != nil {
+		return err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
@@ -358,12 +372,20 @@ func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	vlans, err := getNetworkVlanLinks(m.(ibclient.IBConnector), obj.Ref)
+	if err != nil {
+		return fmt.Errorf("failed to read the VLANs of network block '%s': %w", obj.Cidr, err)
+	}
+	if err = d.Set("vlans", flattenVlanLinks(vlans)); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
 }
 
-func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
+func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -383,6 +405,8 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 			prevResIPv6, _ := d.GetChange("reserve_ipv6")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
+			prevVlans, _ := d.GetChange("vlans")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("cidr", prevCIDR.(string))
@@ -395,45 +419,15 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 			_ = d.Set("reserve_ipv6", prevResIPv6.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
+			_ = d.Set("vlans", prevVlans)
 		}
 	}()
 
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-	if d.HasChange("cidr") {
-		return fmt.Errorf("changing the value of 'cidr' field is not allowed")
-	}
-	if d.HasChange("reserve_ip") {
-		return fmt.Errorf("changing the value of 'reserve_ip' field is not allowed")
-	}
-	if d.HasChange("reserve_ipv6") {
-		return fmt.Errorf("changing the value of 'reserve_ipv6' field is not allowed")
-	}
-	if d.HasChange("gateway") {
-		return fmt.Errorf("changing the value of 'gateway' field is not allowed")
-	}
-	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
-	}
-	if d.HasChange("object") {
-		return fmt.Errorf("changing the value of 'object' field is not allowed")
-	}
-
 	networkViewName := d.Get("network_view").(string)
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -446,7 +440,6 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 	}
 
 	connector := m.(ibclient.IBConnector)
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 	var Network *ibclient.Network
 
 	comment := ""
@@ -455,14 +448,17 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 		comment = commentVal.(string)
 	}
 
-	net, err := objMgr.GetNetworkByRef(d.Id())
+	// The EAs are read along with their inheritance, to keep inheriting the EAs not managed by the resource.
+	isIPv6 := strings.HasPrefix(d.Id(), "ipv6network/")
+	net := ibclient.NewNetwork("", "", isIPv6, "", nil)
+	inheritedEAs, err := getObjectWithInheritedEAs(connector, net, d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read network for update operation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read network for update operation: %w", err))
 	}
 
 	newExtAttrs, err = mergeEAs(net.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Set the Terraform Internal ID to the NIOS EA if it is not already set
@@ -474,29 +470,41 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 
 	newInternalId := newInternalResourceIdFromString(internalId)
 	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+	eaConnector, err := connectorWithEAInheritance(connector, d, m, net.ObjectType(), newExtAttrs, inheritedEAs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objMgr := ibclient.NewObjectManager(eaConnector, "Terraform", tenantID)
 	Network, err = objMgr.UpdateNetwork(net.Ref, newExtAttrs, comment)
 	if err != nil {
-		return fmt.Errorf("Updation of IP Network under network view '%s' failed: '%s'", networkViewName, err.Error())
+		return diag.FromErr(fmt.Errorf("Updation of IP Network under network view '%s' failed: '%s'", networkViewName, err.Error()))
+	}
+	if d.HasChange("vlans") {
+		ref, err := setNetworkVlanLinks(connector, Network.Ref, expandVlanLinks(d.Get("vlans").([]interface{})))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("assignment of VLANs to network block '%s' failed: %w",
+				d.Get("cidr").(string), withWapiErrorAttribute(err, "vlans")))
+		}
+		Network.Ref = ref
 	}
 	updateSuccessful = true
 	d.SetId(Network.Ref)
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", Network.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNetworkDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	networkViewName := d.Get("network_view").(string)
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -514,8 +522,8 @@ func resourceNetworkDelete(d *schema.ResourceData, m interface{}) error {
 	network, err := searchObjectByRefOrInternalId("Network", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -527,67 +535,66 @@ func resourceNetworkDelete(d *schema.ResourceData, m interface{}) error {
 	recJson, _ := json.Marshal(network)
 	err = json.Unmarshal(recJson, &net)
 	if err != nil {
-		return fmt.Errorf("failed to read network for delete operation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read network for delete operation: %w", err))
 	}
 
 	_, err = objMgr.DeleteNetwork(net.Ref)
 	if err != nil {
-		return fmt.Errorf("Deletion of Network block failed from network view(%s): %s", networkViewName, err)
+		return diag.FromErr(fmt.Errorf("Deletion of Network block failed from network view(%s): %s", networkViewName, err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceIPv4NetworkCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkCreate(d, m, false)
+func resourceIPv4NetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNetworkCreate(d, m, false))
 }
 
 func resourceIPv4Network() *schema.Resource {
 	nw := resourceNetwork()
-	nw.Create = resourceIPv4NetworkCreate
-	nw.Read = resourceIPv4NetworkRead
-	nw.Update = resourceNetworkUpdate
-	nw.Delete = resourceNetworkDelete
-	return nw
+	nw.CreateContext = resourceIPv4NetworkCreate
+	nw.ReadContext = resourceIPv4NetworkRead
+	nw.UpdateContext = resourceNetworkUpdate
+	nw.DeleteContext = resourceNetworkDelete
+	return withWapiFieldAttributes(withAdoptExisting(nw, "network", networkNaturalKey), networkNaturalKey)
 }
 
-func resourceIPv6NetworkCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkCreate(d, m, true)
+func resourceIPv6NetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNetworkCreate(d, m, true))
 }
 
 func resourceIPv6Network() *schema.Resource {
 	nw := resourceNetwork()
-	nw.Create = resourceIPv6NetworkCreate
-	nw.Read = resourceIPv6NetworkRead
-	nw.Update = resourceNetworkUpdate
-	nw.Delete = resourceNetworkDelete
-	return nw
+	nw.CreateContext = resourceIPv6NetworkCreate
+	nw.ReadContext = resourceIPv6NetworkRead
+	nw.UpdateContext = resourceNetworkUpdate
+	nw.DeleteContext = resourceNetworkDelete
+	return withWapiFieldAttributes(withAdoptExisting(nw, "ipv6network", networkNaturalKey), networkNaturalKey)
 }
 
-func resourceIPv4NetworkRead(d *schema.ResourceData, m interface{}) error {
+func resourceIPv4NetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ref := d.Id()
 	if !networkIPv4Regexp.MatchString(ref) {
-		return fmt.Errorf("reference '%s' for 'network' object has an invalid format", ref)
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'network' object has an invalid format", ref))
 	}
 
-	return resourceNetworkRead(d, m)
+	return diag.FromErr(resourceNetworkRead(d, m))
 }
 
-func resourceIPv6NetworkRead(d *schema.ResourceData, m interface{}) error {
+func resourceIPv6NetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ref := d.Id()
 	if !networkIPv6Regexp.MatchString(ref) {
-		return fmt.Errorf("reference '%s' for 'ipv6network' object has an invalid format", ref)
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'ipv6network' object has an invalid format", ref))
 	}
 
-	return resourceNetworkRead(d, m)
+	return diag.FromErr(resourceNetworkRead(d, m))
 }
 
-func resourceNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNetworkImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	networkViewName := d.Get("network_view").(string)
 
-	extAttrsJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrsJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -609,15 +616,11 @@ func resourceNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 		return nil, fmt.Errorf("getting Network block from network view (%s) failed : %s", networkViewName, err)
 	}
 
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = removeInheritedEAs(m, obj.Ref, obj.Ea); err != nil {
+		return nil, err
+	}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
@@ -643,9 +646,8 @@ func resourceNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 	}
 
 	d.SetId(obj.Ref)
-	err = resourceNetworkUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceNetworkUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
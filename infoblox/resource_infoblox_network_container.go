@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"regexp"
+	"strings"
 )
 
 var (
@@ -14,10 +16,16 @@ var (
 	netContainerIPv6Regexp = regexp.MustCompile("^ipv6networkcontainer/.+")
 )
 
+// networkContainerNaturalKey maps the arguments identifying the network containers to the WAPI search fields.
+var networkContainerNaturalKey = map[string]string{
+	"cidr":         "network",
+	"network_view": "network_view",
+}
+
 func resourceNetworkContainer() *schema.Resource {
-	return &schema.Resource{
+	return withImmutableFields(withInheritableExtensibleAttributes(&schema.Resource{
 		Importer: &schema.ResourceImporter{
-			State: resourceNetworkContainerImport,
+			StateContext: resourceNetworkContainerImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -76,6 +84,7 @@ func resourceNetworkContainer() *schema.Resource {
 				Optional:    true,
 				Description: "The Extensible attributes of the network container to be added/updated, as a map in JSON format",
 			},
+			"ext_attrs_descendants_action": eaDescendantsActionSchema(),
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -89,7 +98,7 @@ func resourceNetworkContainer() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "network_view", "cidr", "parent_cidr", "allocate_prefix_len", "filter_params")
 }
 
 func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
@@ -108,8 +117,7 @@ func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv
 	nextAvailableFilter := d.Get("filter_params").(string)
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
 		return fmt.Errorf("failed to create network container: %w", err)
 	}
@@ -127,7 +135,14 @@ func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv
 		}
 	}
 
-	connector := m.(ibclient.IBConnector)
+	objType := "networkcontainer"
+	if isIPv6 {
+		objType = "ipv6networkcontainer"
+	}
+	connector, err := connectorWithEAInheritance(m.(ibclient.IBConnector), d, m, objType, extAttrs, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create network container: %w", err)
+	}
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	// Attempt to allocate next available network container
@@ -183,8 +198,7 @@ func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv
 }
 
 func resourceNetworkContainerRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return fmt.Errorf("failed to read network containter: %w", err)
 	}
@@ -217,16 +231,12 @@ func resourceNetworkContainerRead(d *schema.ResourceData, m interface{}) error {
 
 	delete(extAttrs, eaNameForInternalId)
 
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	inheritedEAs, err := readInheritedEAs(d, m, obj.Ref)
+	if err != nil {
+		return err
+	}
+	if err = terraformSetInheritableEAs(d, m, obj.Ea, inheritedEAs); err != nil {
+		return err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
@@ -263,6 +273,7 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 			prevNextAvailableFilter, _ := d.GetChange("filter_params")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("cidr", prevCIDR.(string))
@@ -271,43 +282,14 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 			_ = d.Set("filter_params", prevNextAvailableFilter.(string))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
 
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-
-	if d.HasChange("cidr") {
-		return fmt.Errorf("changing the value of 'cidr' field is not allowed")
-	}
-
-	if d.HasChange("parent_cidr") {
-		return fmt.Errorf("changing the value of 'parent_cidr' field is not allowed")
-	}
-
-	if d.HasChange("allocate_prefix_len") {
-		return fmt.Errorf("changing the value of 'allocate_prefix_len' field is not allowed")
-	}
-
-	if d.HasChange("filter_params") {
-		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
-	}
-
 	nvName := d.Get("network_view").(string)
 	cidr := d.Get("cidr").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
 		return err
 	}
@@ -334,9 +316,11 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	connector := m.(ibclient.IBConnector)
-	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
-	nc, err := objMgr.GetNetworkContainerByRef(d.Id())
+	// The EAs are read along with their inheritance, to keep inheriting the EAs not managed by the resource.
+	isIPv6 := strings.HasPrefix(d.Id(), "ipv6networkcontainer/")
+	nc := ibclient.NewNetworkContainer("", "", isIPv6, "", nil)
+	inheritedEAs, err := getObjectWithInheritedEAs(connector, nc, d.Id())
 	if err != nil {
 		return fmt.Errorf("failed to read network container for update operation: %w", err)
 	}
@@ -352,6 +336,11 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 		comment = commentText.(string)
 	}
 
+	eaConnector, err := connectorWithEAInheritance(connector, d, m, nc.ObjectType(), newExtAttrs, inheritedEAs)
+	if err != nil {
+		return err
+	}
+	objMgr := ibclient.NewObjectManager(eaConnector, "Terraform", tenantID)
 	nc, err = objMgr.UpdateNetworkContainer(d.Id(), newExtAttrs, comment)
 	if err != nil {
 		return fmt.Errorf(
@@ -370,8 +359,7 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetworkContainerDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return fmt.Errorf("failed to delete network container: %w", err)
 	}
@@ -419,25 +407,25 @@ func resourceNetworkContainerDelete(d *schema.ResourceData, m interface{}) error
 //	return false, nil
 //}
 
-func resourceIPv4NetworkContainerCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerCreate(d, m, false)
+func resourceIPv4NetworkContainerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNetworkContainerCreate(d, m, false))
 }
 
-func resourceIPv4NetworkContainerRead(d *schema.ResourceData, m interface{}) error {
+func resourceIPv4NetworkContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ref := d.Id()
 	if !netContainerIPv4Regexp.MatchString(ref) {
-		return fmt.Errorf("reference '%s' for 'networkcontainer' object has an invalid format", ref)
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'networkcontainer' object has an invalid format", ref))
 	}
 
-	return resourceNetworkContainerRead(d, m)
+	return diag.FromErr(resourceNetworkContainerRead(d, m))
 }
 
-func resourceIPv4NetworkContainerUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerUpdate(d, m)
+func resourceIPv4NetworkContainerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNetworkContainerUpdate(d, m))
 }
 
-func resourceIPv4NetworkContainerDelete(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerDelete(d, m)
+func resourceIPv4NetworkContainerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNetworkContainerDelete(d, m))
 }
 
 //func resourceIPv4NetworkContainerExists(d *schema.ResourceData, m interface{}) (bool, error) {
@@ -446,34 +434,34 @@ func resourceIPv4NetworkContainerDelete(d *schema.ResourceData, m interface{}) e
 
 func resourceIPv4NetworkContainer() *schema.Resource {
 	nc := resourceNetworkContainer()
-	nc.Create = resourceIPv4NetworkContainerCreate
-	nc.Read = resourceIPv4NetworkContainerRead
-	nc.Update = resourceIPv4NetworkContainerUpdate
-	nc.Delete = resourceIPv4NetworkContainerDelete
+	nc.CreateContext = resourceIPv4NetworkContainerCreate
+	nc.ReadContext = resourceIPv4NetworkContainerRead
+	nc.UpdateContext = resourceIPv4NetworkContainerUpdate
+	nc.DeleteContext = resourceIPv4NetworkContainerDelete
 	//nc.Exists = resourceIPv4NetworkContainerExists
 
-	return nc
+	return withWapiFieldAttributes(withAdoptExisting(nc, "networkcontainer", networkContainerNaturalKey), networkContainerNaturalKey)
 }
 
-func resourceIPv6NetworkContainerCreate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerCreate(d, m, true)
+func resourceIPv6NetworkContainerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNetworkContainerCreate(d, m, true))
 }
 
-func resourceIPv6NetworkContainerRead(d *schema.ResourceData, m interface{}) error {
+func resourceIPv6NetworkContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ref := d.Id()
 	if !netContainerIPv6Regexp.MatchString(ref) {
-		return fmt.Errorf("reference '%s' for 'ipv6networkcontainer' object has an invalid format", ref)
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'ipv6networkcontainer' object has an invalid format", ref))
 	}
 
-	return resourceNetworkContainerRead(d, m)
+	return diag.FromErr(resourceNetworkContainerRead(d, m))
 }
 
-func resourceIPv6NetworkContainerUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerUpdate(d, m)
+func resourceIPv6NetworkContainerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNetworkContainerUpdate(d, m))
 }
 
-func resourceIPv6NetworkContainerDelete(d *schema.ResourceData, m interface{}) error {
-	return resourceNetworkContainerDelete(d, m)
+func resourceIPv6NetworkContainerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNetworkContainerDelete(d, m))
 }
 
 //func resourceIPv6NetworkContainerExists(d *schema.ResourceData, m interface{}) (bool, error) {
@@ -482,18 +470,17 @@ func resourceIPv6NetworkContainerDelete(d *schema.ResourceData, m interface{}) e
 
 func resourceIPv6NetworkContainer() *schema.Resource {
 	nc := resourceNetworkContainer()
-	nc.Create = resourceIPv6NetworkContainerCreate
-	nc.Read = resourceIPv6NetworkContainerRead
-	nc.Update = resourceIPv6NetworkContainerUpdate
-	nc.Delete = resourceIPv6NetworkContainerDelete
+	nc.CreateContext = resourceIPv6NetworkContainerCreate
+	nc.ReadContext = resourceIPv6NetworkContainerRead
+	nc.UpdateContext = resourceIPv6NetworkContainerUpdate
+	nc.DeleteContext = resourceIPv6NetworkContainerDelete
 	//nc.Exists = resourceIPv6NetworkContainerExists
 
-	return nc
+	return withWapiFieldAttributes(withAdoptExisting(nc, "ipv6networkcontainer", networkContainerNaturalKey), networkContainerNaturalKey)
 }
 
-func resourceNetworkContainerImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceNetworkContainerImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read network containter: %w", err)
 	}
@@ -512,15 +499,11 @@ func resourceNetworkContainerImport(d *schema.ResourceData, m interface{}) ([]*s
 		return nil, fmt.Errorf("failed to retrieve network container: %w", err)
 	}
 
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = removeInheritedEAs(m, obj.Ref, obj.Ea); err != nil {
+		return nil, err
+	}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
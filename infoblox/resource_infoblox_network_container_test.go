@@ -550,6 +550,101 @@ func TestAcc_resourceNetworkContainer_ipv4_ea_inheritance(t *testing.T) {
 	})
 }
 
+func TestAcc_resourceNetworkContainer_ipv4_ea_inheritance_operation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "parent" {
+					  network_view = "default"
+					  cidr = "10.10.0.0/16"
+					  comment = "parent network container"
+					  extensible_attributes {
+						name = "Site"
+						value = "Parent site"
+					  }
+					  ext_attrs_descendants_action {
+						option_with_ea = "INHERIT"
+					  }
+					}
+					resource "infoblox_ipv4_network" "child" {
+					  network_view = "default"
+					  cidr = "10.10.1.0/24"
+					  comment = "child network"
+					  extensible_attributes {
+						name = "Site"
+						inheritance_operation = "INHERIT"
+					  }
+					  extensible_attributes {
+						name = "Location"
+						value = "Child location"
+					  }
+					  depends_on = [infoblox_ipv4_network_container.parent]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.child", "extensible_attributes.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.child", "all_ext_attrs", `{"Location":"Child location"}`),
+					validateNetwork(
+						"infoblox_ipv4_network.child",
+						&ibclient.Network{
+							NetviewName: "default",
+							Comment:     "child network",
+							Ea: ibclient.EA{
+								"Site":     "Parent site",
+								"Location": "Child location",
+							},
+						},
+					),
+				),
+			},
+			// A change of the parent's EA must be pushed down to the child network without a drift.
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "parent" {
+					  network_view = "default"
+					  cidr = "10.10.0.0/16"
+					  comment = "parent network container"
+					  extensible_attributes {
+						name = "Site"
+						value = "Updated parent site"
+					  }
+					  ext_attrs_descendants_action {
+						option_with_ea = "INHERIT"
+					  }
+					}
+					resource "infoblox_ipv4_network" "child" {
+					  network_view = "default"
+					  cidr = "10.10.1.0/24"
+					  comment = "child network"
+					  extensible_attributes {
+						name = "Site"
+						inheritance_operation = "INHERIT"
+					  }
+					  extensible_attributes {
+						name = "Location"
+						value = "Child location"
+					  }
+					  depends_on = [infoblox_ipv4_network_container.parent]
+					}`,
+				Check: validateNetwork(
+					"infoblox_ipv4_network.child",
+					&ibclient.Network{
+						NetviewName: "default",
+						Comment:     "child network",
+						Ea: ibclient.EA{
+							"Site":     "Updated parent site",
+							"Location": "Child location",
+						},
+					},
+				),
+			},
+		},
+	})
+}
+
 var testResourceIPv4NetworkContainer = `resource "infoblox_ipv4_network_container" "ipv4_network12" {
   cidr = "25.11.0.0/24"
   network_view = "default"
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"regexp"
 
@@ -14,14 +15,19 @@ var (
 	networkViewRegExp = regexp.MustCompile("^networkview/.+")
 )
 
+// networkViewNaturalKey maps the arguments identifying the network views to the WAPI search fields.
+var networkViewNaturalKey = map[string]string{
+	"name": "name",
+}
+
 func resourceNetworkView() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNetworkViewCreate,
-		Read:   resourceNetworkViewRead,
-		Update: resourceNetworkViewUpdate,
-		Delete: resourceNetworkViewDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceNetworkViewCreate,
+		ReadContext:   resourceNetworkViewRead,
+		UpdateContext: resourceNetworkViewUpdate,
+		DeleteContext: resourceNetworkViewDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNetworkViewImport,
+			StateContext: resourceNetworkViewImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -65,19 +71,18 @@ func resourceNetworkView() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "networkview", networkViewNaturalKey), networkViewNaturalKey)
 }
 
-func resourceNetworkViewCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 	networkView := d.Get("name").(string)
 	comment := d.Get("comment").(string)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -94,31 +99,24 @@ func resourceNetworkViewCreate(d *schema.ResourceData, m interface{}) error {
 
 	nv, err := objMgr.CreateNetworkView(networkView, comment, extAttrs)
 	if err != nil {
-		return fmt.Errorf("Failed to create Network View : %s", err)
+		return diag.FromErr(fmt.Errorf("Failed to create Network View : %s", err))
 	}
 	d.SetId(nv.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", nv.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceNetworkViewRead(d *schema.ResourceData, m interface{}) error {
-
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
-
+func resourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	obj, err := searchObjectByRefOrInternalId("NetworkView", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -130,40 +128,32 @@ func resourceNetworkViewRead(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &nv)
 
 	if err != nil {
-		return fmt.Errorf("Failed to get Network View : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("Failed to get Network View : %s", err.Error()))
 	}
 
 	if !networkViewRegExp.MatchString(nv.Ref) {
-		return fmt.Errorf("reference '%s' for 'networkview' object has an invalid format", nv.Ref)
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'networkview' object has an invalid format", nv.Ref))
 	}
 	delete(nv.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(nv.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, nv.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(nv.Ref)
 	if err = d.Set("ref", nv.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("name", nv.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("comment", nv.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -173,30 +163,21 @@ func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
 			prevName, _ := d.GetChange("name")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("name", prevName.(string))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
 
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-
 	networkView := d.Get("name").(string)
 	comment := d.Get("comment").(string)
 
-	oldExtAttrJSON, newExtAttrJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrJSON.(string))
-	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -209,7 +190,7 @@ func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
 
 	nv, err := objMgr.GetNetworkViewByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read network for update operation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read network for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -223,36 +204,35 @@ func resourceNetworkViewUpdate(d *schema.ResourceData, m interface{}) error {
 
 	updExtAttrs, err := mergeEAs(nv.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nv, err = objMgr.UpdateNetworkView(d.Id(), networkView, comment, updExtAttrs)
 	if err != nil {
-		return fmt.Errorf("Failed to update Network View : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("Failed to update Network View : %s", err.Error()))
 	}
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", nv.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(nv.Ref)
 
 	return nil
 }
 
-func resourceNetworkViewDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetworkViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if d.HasChange("name") {
-		return fmt.Errorf("changing the value of 'networkView' field is not recommended")
+		return diag.FromErr(fmt.Errorf("changing the value of 'networkView' field is not recommended"))
 	}
 	networkView := d.Get("name").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var tenantID string
 	if tempVal, ok := extAttrs[eaNameForTenantId]; ok {
@@ -262,8 +242,8 @@ func resourceNetworkViewDelete(d *schema.ResourceData, m interface{}) error {
 	obj, err := searchObjectByRefOrInternalId("NetworkView", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -274,22 +254,21 @@ func resourceNetworkViewDelete(d *schema.ResourceData, m interface{}) error {
 	recJson, _ := json.Marshal(obj)
 	err = json.Unmarshal(recJson, &nv)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 	_, err = objMgr.DeleteNetworkView(nv.Ref)
 	if err != nil {
-		return fmt.Errorf("Deletion of Network view %s failed: %s", networkView, err.Error())
+		return diag.FromErr(fmt.Errorf("Deletion of Network view %s failed: %s", networkView, err.Error()))
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNetworkViewImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceNetworkViewImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -311,14 +290,8 @@ func resourceNetworkViewImport(d *schema.ResourceData, m interface{}) ([]*schema
 		return nil, fmt.Errorf("reference '%s' for 'networkview' object has an invalid format", d.Id())
 	}
 
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	d.SetId(obj.Ref)
@@ -330,9 +303,8 @@ func resourceNetworkViewImport(d *schema.ResourceData, m interface{}) ([]*schema
 	}
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceNetworkViewUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceNetworkViewUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
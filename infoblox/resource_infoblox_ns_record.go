@@ -1,20 +1,22 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"strings"
 )
 
 func resourceNSRecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNSRecordCreate,
-		Read:   resourceNSRecordRead,
-		Update: resourceNSRecordUpdate,
-		Delete: resourceNSRecordDelete,
+	return withImmutableFields(&schema.Resource{
+		CreateContext: resourceNSRecordCreate,
+		ReadContext:   resourceNSRecordRead,
+		UpdateContext: resourceNSRecordUpdate,
+		DeleteContext: resourceNSRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNSRecordImport,
+			StateContext: resourceNSRecordImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -72,9 +74,9 @@ func resourceNSRecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}, "name", "dns_view")
 }
-func resourceNSRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNSRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	nameserver := d.Get("nameserver").(string)
 	addressesInterface := d.Get("addresses").([]interface{})
@@ -85,16 +87,16 @@ func resourceNSRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	recordNS, err := objMgr.CreateNSRecord(name, nameserver, dnsView, addresses, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(recordNS.Ref)
 	if err = d.Set("ref", recordNS.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNSRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		if !updateSuccessful {
@@ -110,16 +112,6 @@ func resourceNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("addresses", prevAddresses)
 		}
 	}()
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("name") {
-		return fmt.Errorf("changing the value of 'name' field is not allowed")
-	}
-	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
-	}
-
 	nameserver := d.Get("nameserver").(string)
 	addressesInterface := d.Get("addresses").([]interface{})
 	addresses := ConvertInterfaceToZoneNameServers(addressesInterface)
@@ -129,62 +121,62 @@ func resourceNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := objMgr.UpdateNSRecord(
 		d.Id(), "", nameserver, "", addresses, "")
 	if err != nil {
-		return fmt.Errorf("error updating NS-Record: %s", err)
+		return diag.FromErr(fmt.Errorf("error updating NS-Record: %s", err))
 	}
 	updateSuccessful = true
 	d.SetId(rec.Ref)
 	if err = d.Set("ref", rec.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceNSRecordRead(d, m)
+	return resourceNSRecordRead(ctx, d, m)
 }
-func resourceNSRecordRead(d *schema.ResourceData, m interface{}) error {
+func resourceNSRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
 
 	recordNS, err := objMgr.GetNSRecordByRef(d.Id())
 
 	if err != nil {
-		return fmt.Errorf("failed getting NS-record: %w", err)
+		return diag.FromErr(fmt.Errorf("failed getting NS-record: %w", err))
 	}
 
 	if err = d.Set("name", recordNS.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("nameserver", recordNS.Nameserver); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("dns_view", recordNS.View); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if recordNS.Addresses != nil {
 		addressInterface := convertZoneNameServersToInterface(recordNS.Addresses)
 		if err = d.Set("addresses", addressInterface); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if err = d.Set("ref", recordNS.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
-func resourceNSRecordDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNSRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
 
 	recordNS, err := objMgr.GetNSRecordByRef(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = objMgr.DeleteARecord(recordNS.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of NS-record failed: %w", err)
+		return diag.FromErr(fmt.Errorf("deletion of NS-record failed: %w", err))
 	}
 	d.SetId("")
 
 	return nil
 }
-func resourceNSRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNSRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
 
@@ -212,9 +204,8 @@ func resourceNSRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 
 	// Resource NSRecord update Terraform Internal ID and Ref on NIOS side
 	// After the record is imported, call the update function
-	err = resourceNSRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceNSRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
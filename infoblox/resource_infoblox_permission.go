@@ -0,0 +1,177 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+var (
+	permissionRegExp       = regexp.MustCompile("^permission/.+")
+	permissionTypes        = []string{"DENY", "READ", "WRITE"}
+	permissionReturnFields = []string{"group", "role", "object", "resource_type", "permission"}
+)
+
+func resourcePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePermissionCreate,
+		ReadContext:   resourcePermissionRead,
+		UpdateContext: resourcePermissionUpdate,
+		DeleteContext: resourcePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"group", "role"},
+				Description:  "The name of the admin group the permission applies to.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"group", "role"},
+				Description:  "The name of the admin role the permission applies to.",
+			},
+			"object": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"object", "resource_type"},
+				Description: "The reference of the NIOS object the permission applies to. If 'resource_type' is specified " +
+					"as well, the permission applies to the child objects of the given type.",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"object", "resource_type"},
+				Description:  "The type of the objects the permission applies to, for example 'A' or 'NETWORK'.",
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(permissionTypes, false),
+				Description:  "The type of the permission: READ (read-only), WRITE (read/write) or DENY.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	fields := map[string]interface{}{
+		"permission": d.Get("permission").(string),
+	}
+	for _, field := range []string{"group", "role", "object", "resource_type"} {
+		if value := d.Get(field).(string); value != "" {
+			fields[field] = value
+		}
+	}
+
+	ref, err := conn.CreateObject(newWapiRawObject("permission", fields))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of permission failed: %w", err))
+	}
+	d.SetId(ref)
+
+	return resourcePermissionRead(ctx, d, m)
+}
+
+func resourcePermissionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !permissionRegExp.MatchString(d.Id()) {
+		return diag.FromErr(fmt.Errorf("reference '%s' for 'permission' object has an invalid format", d.Id()))
+	}
+
+	conn := m.(ibclient.IBConnector)
+
+	p := &ibclient.Permission{}
+	p.SetReturnFields(permissionReturnFields)
+	var res ibclient.Permission
+	if err := conn.GetObject(p, d.Id(), nil, &res); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read permission '%s': %w", d.Id(), err))
+	}
+
+	for field, value := range flattenPermission(res) {
+		if field == "id" {
+			continue
+		}
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("ref", res.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(res.Ref)
+
+	return nil
+}
+
+func resourcePermissionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	obj := newWapiRawObject("permission", map[string]interface{}{
+		"permission": d.Get("permission").(string),
+	})
+	ref, err := conn.UpdateObject(obj, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of permission '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourcePermissionRead(ctx, d, m)
+}
+
+func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of permission '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func flattenPermission(p ibclient.Permission) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":            p.Ref,
+		"permission":    p.Permission,
+		"resource_type": p.ResourceType,
+		"group":         "",
+		"role":          "",
+		"object":        "",
+	}
+	if p.Group != nil {
+		res["group"] = *p.Group
+	}
+	if p.Role != nil {
+		res["role"] = *p.Role
+	}
+	if p.Object != nil {
+		res["object"] = *p.Object
+	}
+
+	return res
+}
@@ -0,0 +1,62 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"testing"
+)
+
+func testAccCheckPermissionDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_permission", func() ibclient.IBObject {
+		return &ibclient.Permission{}
+	})(s)
+}
+
+func TestAcc_resourcePermission(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_admin_role" "test_role" {
+						name = "tf_acc_test_permission_role"
+					}
+					resource "infoblox_dns_view" "test_view" {
+						name = "tf_acc_test_permission_view"
+					}
+					resource "infoblox_permission" "test_permission" {
+						role = infoblox_admin_role.test_role.name
+						object = infoblox_dns_view.test_view.ref
+						resource_type = "A"
+						permission = "READ"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_permission.test_permission", "role", "tf_acc_test_permission_role"),
+					resource.TestCheckResourceAttr("infoblox_permission.test_permission", "resource_type", "A"),
+					resource.TestCheckResourceAttr("infoblox_permission.test_permission", "permission", "READ"),
+					resource.TestCheckResourceAttrPair(
+						"infoblox_permission.test_permission", "object", "infoblox_dns_view.test_view", "ref"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_admin_role" "test_role" {
+						name = "tf_acc_test_permission_role"
+					}
+					resource "infoblox_dns_view" "test_view" {
+						name = "tf_acc_test_permission_view"
+					}
+					resource "infoblox_permission" "test_permission" {
+						role = infoblox_admin_role.test_role.name
+						object = infoblox_dns_view.test_view.ref
+						resource_type = "A"
+						permission = "WRITE"
+					}`,
+				Check: resource.TestCheckResourceAttr("infoblox_permission.test_permission", "permission", "WRITE"),
+			},
+		},
+	})
+}
@@ -4,19 +4,27 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+// ptrRecordNaturalKey maps the arguments identifying the PTR-records to the WAPI search fields.
+var ptrRecordNaturalKey = map[string]string{
+	"ptrdname":    "ptrdname",
+	"record_name": "name",
+	"dns_view":    "view",
+}
+
 func resourcePTRRecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourcePTRRecordCreate,
-		Read:   resourcePTRRecordGet,
-		Update: resourcePTRRecordUpdate,
-		Delete: resourcePTRRecordDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourcePTRRecordCreate,
+		ReadContext:   resourcePTRRecordGet,
+		UpdateContext: resourcePTRRecordUpdate,
+		DeleteContext: resourcePTRRecordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourcePTRRecordImport,
+			StateContext: resourcePTRRecordImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -94,18 +102,18 @@ func resourcePTRRecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "network_view", "dns_view"), "record:ptr", ptrRecordNaturalKey), ptrRecordNaturalKey)
 }
 
-func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourcePTRRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	networkView, trimmed := checkAndTrimSpaces(d.Get("network_view").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "network_view")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "network_view"))
 	}
 	if networkView == "" {
 		networkView = defaultNetView
@@ -115,7 +123,7 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	cidr, trimmed := checkAndTrimSpaces(d.Get("cidr").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "cidr")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "cidr"))
 	}
 	if cidr != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -123,7 +131,7 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	ipAddr, trimmed := checkAndTrimSpaces(d.Get("ip_addr").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ip_addr")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ip_addr"))
 	}
 	if ipAddr != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -131,27 +139,26 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	dnsViewName, trimmed := checkAndTrimSpaces(d.Get("dns_view").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "dns_view")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "dns_view"))
 	}
 
 	ptrdname, trimmed := checkAndTrimSpaces(d.Get("ptrdname").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ptrdname")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ptrdname"))
 	}
 
 	recordName, trimmed := checkAndTrimSpaces(d.Get("record_name").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name"))
 	}
 	if recordName != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
 	}
 
 	comment := d.Get("comment").(string)
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -164,14 +171,14 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if ipAddrSrcCounter == 0 {
-		return fmt.Errorf(
+		return diag.FromErr(fmt.Errorf(
 			"'ip_addr' or 'cidr' are mandatory in reverse mapping zone " +
-				"and 'record_name' is mandatory in forward mapping zone")
+				"and 'record_name' is mandatory in forward mapping zone"))
 	}
 
 	if ipAddrSrcCounter != 1 {
-		return fmt.Errorf(
-			"only one of 'ip_addr', 'cidr' and 'record_name' must be defined")
+		return diag.FromErr(fmt.Errorf(
+			"only one of 'ip_addr', 'cidr' and 'record_name' must be defined"))
 	}
 
 	var ttl uint32
@@ -182,7 +189,7 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -200,7 +207,7 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 		comment,
 		extAttrs)
 	if err != nil {
-		return fmt.Errorf("creation of PTR-record under the DNS view '%s' failed: %s", dnsViewName, err)
+		return diag.FromErr(fmt.Errorf("creation of PTR-record under the DNS view '%s' failed: %s", dnsViewName, err))
 	}
 
 	// After reading a newly created object, IP address will be
@@ -212,32 +219,32 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("ref", recordPTR.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ip_addr", ipAddr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("record_name", recordPTR.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsViewObj, err := objMgr.GetDNSView(dnsViewName)
 		if err != nil {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %s",
-				dnsViewName, err)
+				dnsViewName, err))
 		}
 		if err = d.Set("network_view", dnsViewObj.NetworkView); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err = d.Set("comment", comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(recordPTR.Ref)
@@ -245,12 +252,11 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
+func resourcePTRRecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -264,8 +270,8 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("PTR", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -278,7 +284,7 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil && obj.Ref != "" {
-		return fmt.Errorf("getting PTR-record with ID '%s' failed: %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("getting PTR-record with ID '%s' failed: %s", d.Id(), err))
 	}
 
 	if obj.Ttl != nil {
@@ -289,46 +295,38 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, obj.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if val, ok := d.GetOk("network_view"); !ok || val.(string) == "" {
 		dnsView, err := objMgr.GetDNSView(obj.View)
 		if err != nil {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"error while retrieving information about DNS view '%s': %s",
-				obj.View, err)
+				obj.View, err))
 		}
 		if err = d.Set("network_view", dnsView.NetworkView); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err = d.Set("ptrdname", obj.PtrdName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var ipAddr string
@@ -338,10 +336,10 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 		ipAddr = *obj.Ipv6Addr
 	}
 	if err = d.Set("ip_addr", ipAddr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("record_name", obj.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(obj.Ref)
@@ -349,7 +347,7 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourcePTRRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -365,6 +363,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("network_view", prevNetView.(string))
 			_ = d.Set("dns_view", prevDNSView.(string))
@@ -375,21 +374,10 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
 
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-
-	if d.HasChange("network_view") {
-		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
-	}
-
-	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
-	}
-
 	networkView := d.Get("network_view").(string)
 	ptrdname := d.Get("ptrdname").(string)
 	dnsView := d.Get("dns_view").(string)
@@ -399,7 +387,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	recordName, trimmed := checkAndTrimSpaces(d.Get("record_name").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name"))
 	}
 	if recordName != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -410,7 +398,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	ipAddr, trimmed := checkAndTrimSpaces(d.Get("ip_addr").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ip_addr")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ip_addr"))
 	}
 	if ipAddr != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -422,7 +410,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	cidr, trimmed := checkAndTrimSpaces(d.Get("cidr").(string))
 	if trimmed {
-		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "cidr")
+		return diag.FromErr(fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "cidr"))
 	}
 	if cidr != "" {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
@@ -442,26 +430,19 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if ipAddrSrcCounter == 0 {
-		return fmt.Errorf(
-			"'ip_addr' or 'cidr' are mandatory in reverse mapping zone and 'record_name' is mandatory in forward mapping zone")
+		return diag.FromErr(fmt.Errorf(
+			"'ip_addr' or 'cidr' are mandatory in reverse mapping zone and 'record_name' is mandatory in forward mapping zone"))
 	}
 
 	if ipAddrSrcChangesCounter > 1 {
-		return fmt.Errorf("only one of 'ip_addr', 'cidr' and 'record_name' must be defined")
+		return diag.FromErr(fmt.Errorf("only one of 'ip_addr', 'cidr' and 'record_name' must be defined"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -477,7 +458,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	connector := m.(ibclient.IBConnector)
@@ -488,7 +469,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	if cidr == "" && ipAddr == "" {
 		recordPTR, err := objMgr.GetPTRRecordByRef(d.Id())
 		if err != nil {
-			return fmt.Errorf("getting PTR-record with ID '%s' failed: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("getting PTR-record with ID '%s' failed: %s", d.Id(), err))
 		}
 
 		ipv4 := recordPTR.Ipv4Addr
@@ -502,7 +483,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	ptrrec, err := objMgr.GetPTRRecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read PTR Record for update operation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read PTR Record for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -516,12 +497,12 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(ptrrec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordPTRUpdated, err := objMgr.UpdatePTRRecord(d.Id(), networkView, ptrdname, recordName, cidr, ipAddr, useTtl, ttl, comment, newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("update operaiton failed for the PTR-record with ID '%s' under the DNS view '%s': %s", d.Id(), dnsView, err)
+		return diag.FromErr(fmt.Errorf("update operaiton failed for the PTR-record with ID '%s' under the DNS view '%s': %s", d.Id(), dnsView, err))
 	}
 	updateSuccessful = true
 	d.SetId(recordPTRUpdated.Ref)
@@ -535,29 +516,28 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if err = d.Set("ref", recordPTRUpdated.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ip_addr", ipAddr); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("record_name", recordPTRUpdated.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
+func resourcePTRRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	dnsView := d.Get("dns_view").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -571,8 +551,8 @@ func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
 	ptrrec, err := searchObjectByRefOrInternalId("PTR", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -585,7 +565,7 @@ func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil {
-		return fmt.Errorf("getting PTR Record with ID: %s failed: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("getting PTR Record with ID: %s failed: %w", d.Id(), err))
 	}
 
 	_, err = objMgr.DeletePTRRecord(obj.Ref)
@@ -593,16 +573,15 @@ func resourcePTRRecordDelete(d *schema.ResourceData, m interface{}) error {
 		if isNotFoundError(err) {
 			d.SetId("")
 		}
-		return fmt.Errorf("deletion of PTR-record with ID '%s'under the DNS view '%s' failed: %s", d.Id(), dnsView, err)
+		return diag.FromErr(fmt.Errorf("deletion of PTR-record with ID '%s'under the DNS view '%s' failed: %s", d.Id(), dnsView, err))
 	}
 
 	return nil
 }
 
-func resourcePTRRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourcePTRRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -637,14 +616,8 @@ func resourcePTRRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 		return nil, err
 	}
 
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
@@ -685,9 +658,8 @@ func resourcePTRRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 
 	d.SetId(obj.Ref)
 
-	err = resourcePTRRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourcePTRRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
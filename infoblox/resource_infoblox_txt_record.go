@@ -4,19 +4,27 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+// txtRecordNaturalKey maps the arguments identifying the TXT-records to the WAPI search fields.
+var txtRecordNaturalKey = map[string]string{
+	"fqdn":     "name",
+	"text":     "text",
+	"dns_view": "view",
+}
+
 func resourceTXTRecord() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceTXTRecordCreate,
-		Read:   resourceTXTRecordGet,
-		Update: resourceTXTRecordUpdate,
-		Delete: resourceTXTRecordDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceTXTRecordCreate,
+		ReadContext:   resourceTXTRecordGet,
+		UpdateContext: resourceTXTRecordUpdate,
+		DeleteContext: resourceTXTRecordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceTXTRecordImport,
+			StateContext: resourceTXTRecordImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -76,20 +84,20 @@ func resourceTXTRecord() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "dns_view"), "record:txt", txtRecordNaturalKey), txtRecordNaturalKey)
 }
 
-func resourceTXTRecordCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTXTRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	dnsView := d.Get("dns_view").(string)
 	fqdn := d.Get("fqdn").(string)
 	text := d.Get("text").(string)
 	if text == "" {
-		return fmt.Errorf("empty 'text' value is not allowed")
+		return diag.FromErr(fmt.Errorf("empty 'text' value is not allowed"))
 	}
 
 	var ttl uint32
@@ -100,15 +108,14 @@ func resourceTXTRecordCreate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL value must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL value must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -127,34 +134,29 @@ func resourceTXTRecordCreate(d *schema.ResourceData, m interface{}) error {
 	newRecord, err := objMgr.CreateTXTRecord(dnsView, fqdn, text, ttl, useTtl, comment, extAttrs)
 
 	if err != nil {
-		return fmt.Errorf("error creating TXT-Record: %s", err)
+		return diag.FromErr(fmt.Errorf("error creating TXT-Record: %s", err))
 	}
 
 	d.SetId(newRecord.Ref)
 
 	if err = d.Set("ref", newRecord.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTXTRecordGet(d *schema.ResourceData, m interface{}) error {
+func resourceTXTRecordGet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
 
 	rec, err := searchObjectByRefOrInternalId("TXT", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -167,11 +169,11 @@ func resourceTXTRecordGet(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil && obj.Ref != "" {
-		return fmt.Errorf("failed getting TXT-Record: %s", err)
+		return diag.FromErr(fmt.Errorf("failed getting TXT-Record: %s", err))
 	}
 
 	if err = d.Set("text", obj.Text); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if obj.Ttl != nil {
@@ -182,36 +184,28 @@ func resourceTXTRecordGet(d *schema.ResourceData, m interface{}) error {
 		ttl = ttlUndef
 	}
 	if err = d.Set("ttl", ttl); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	delete(obj.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(obj.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, obj.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("dns_view", obj.View); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("fqdn", obj.Name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("ref", obj.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(obj.Ref)
@@ -219,7 +213,7 @@ func resourceTXTRecordGet(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTXTRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -232,6 +226,7 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("dns_view", prevDNSView.(string))
 			_ = d.Set("fqdn", prevFQDN.(string))
@@ -239,19 +234,12 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-
-	if d.HasChange("dns_view") {
-		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
-	}
-
 	text := d.Get("text").(string)
 	if text == "" {
-		return fmt.Errorf("empty 'text' value is not allowed")
+		return diag.FromErr(fmt.Errorf("empty 'text' value is not allowed"))
 	}
 
 	fqdn := d.Get("fqdn").(string)
@@ -264,21 +252,14 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		useTtl = true
 		ttl = uint32(tempTTL)
 	} else if tempTTL != ttlUndef {
-		return fmt.Errorf("TTL must be 0 or higher")
+		return diag.FromErr(fmt.Errorf("TTL must be 0 or higher"))
 	}
 
 	comment := d.Get("comment").(string)
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -291,7 +272,7 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	txtrec, err := objMgr.GetTXTRecordByRef(d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to read TXT Record for update operation: %w", err)
+		return diag.FromErr(fmt.Errorf("failed to read TXT Record for update operation: %w", err))
 	}
 
 	internalId := d.Get("internal_id").(string)
@@ -305,31 +286,30 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(txtrec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	rec, err := objMgr.UpdateTXTRecord(
 		d.Id(), fqdn, text, ttl, useTtl, comment, newExtAttrs)
 	if err != nil {
-		return fmt.Errorf("error updating TXT-Record: %s", err)
+		return diag.FromErr(fmt.Errorf("error updating TXT-Record: %s", err))
 	}
 	updateSuccessful = true
 	d.SetId(rec.Ref)
 
 	if err = d.Set("ref", rec.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTXTRecordDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceTXTRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var tenantID string
 	tempVal, found := extAttrs[eaNameForTenantId]
@@ -341,8 +321,8 @@ func resourceTXTRecordDelete(d *schema.ResourceData, m interface{}) error {
 	txtrec, err := searchObjectByRefOrInternalId("TXT", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -355,22 +335,21 @@ func resourceTXTRecordDelete(d *schema.ResourceData, m interface{}) error {
 	err = json.Unmarshal(recJson, &obj)
 
 	if err != nil {
-		return fmt.Errorf("failed getting TXT-Record: %s", err)
+		return diag.FromErr(fmt.Errorf("failed getting TXT-Record: %s", err))
 	}
 
 	_, err = objMgr.DeleteTXTRecord(obj.Ref)
 	if err != nil {
-		return fmt.Errorf("deletion of TXT-Record failed: %s", err)
+		return diag.FromErr(fmt.Errorf("deletion of TXT-Record failed: %s", err))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceTXTRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceTXTRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	var ttl int
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -410,14 +389,8 @@ func resourceTXTRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 		return nil, err
 	}
 
-	if obj.Ea != nil && len(obj.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(obj.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, obj.Ea); err != nil {
+		return nil, err
 	}
 
 	if err = d.Set("comment", obj.Comment); err != nil {
@@ -434,9 +407,8 @@ func resourceTXTRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 
 	d.SetId(obj.Ref)
 
-	err = resourceTXTRecordUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceTXTRecordUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 
 	return []*schema.ResourceData{d}, nil
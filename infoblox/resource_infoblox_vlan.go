@@ -0,0 +1,406 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+	"strings"
+)
+
+var (
+	vlanRegExp = regexp.MustCompile("^vlan/.+")
+
+	vlanReturnFields = []string{
+		"id", "name", "parent", "comment", "contact", "department", "description", "reserved", "status",
+		"assigned_to", "extattrs"}
+)
+
+// vlanObject holds the fields of the 'vlan' object; 'assigned_to' is a list of references,
+// which cannot be unmarshalled into the go-client's Vlan struct.
+type vlanObject struct {
+	Ref         string      `json:"_ref"`
+	ID          uint32      `json:"id"`
+	Name        string      `json:"name"`
+	Parent      string      `json:"parent"`
+	Comment     string      `json:"comment"`
+	Contact     string      `json:"contact"`
+	Department  string      `json:"department"`
+	Description string      `json:"description"`
+	Reserved    bool        `json:"reserved"`
+	Status      string      `json:"status"`
+	AssignedTo  []string    `json:"assigned_to"`
+	Ea          ibclient.EA `json:"extattrs"`
+}
+
+func resourceVlan() *schema.Resource {
+	return withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceVlanCreate,
+		ReadContext:   resourceVlanRead,
+		UpdateContext: resourceVlanUpdate,
+		DeleteContext: resourceVlanDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVlanImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the VLAN.",
+			},
+			"vlan_view": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the VLAN view the VLAN belongs to.",
+			},
+			"vlan_range": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the VLAN range of the VLAN view the VLAN belongs to.",
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(minVlanID, maxVlanID),
+				Description: "The VLAN ID. If not set, the next available VLAN ID of the VLAN range " +
+					"or, if the range is not set, of the VLAN view is allocated.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the VLAN; maximum 256 characters.",
+			},
+			"contact": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The contact information of the person or team managing the VLAN.",
+			},
+			"department": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The department the VLAN is used by.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the VLAN.",
+			},
+			"reserved": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the VLAN can be assigned to networks only manually.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the VLAN: ASSIGNED, UNASSIGNED or RESERVED.",
+			},
+			"assigned_to": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The references of the networks the VLAN is assigned to.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The Extensible attributes of the VLAN, as a map in JSON format",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	})
+}
+
+func expandVlan(d *schema.ResourceData, extAttrs ibclient.EA) *wapiRawObject {
+	return newWapiRawObject("vlan", map[string]interface{}{
+		"id":          d.Get("vlan_id").(int),
+		"name":        d.Get("name").(string),
+		"comment":     d.Get("comment").(string),
+		"contact":     d.Get("contact").(string),
+		"department":  d.Get("department").(string),
+		"description": d.Get("description").(string),
+		"reserved":    d.Get("reserved").(bool),
+		"extattrs":    extAttrs,
+	})
+}
+
+// getVlanParentRef returns the reference of the VLAN range or, if the range is not set, of the VLAN view.
+func getVlanParentRef(conn ibclient.IBConnector, viewName, rangeName string) (string, error) {
+	viewRef, err := getVlanViewRef(conn, viewName)
+	if err != nil || rangeName == "" {
+		return viewRef, err
+	}
+
+	return getVlanRangeRef(conn, viewRef, rangeName)
+}
+
+// getVlanParentNames returns the names of the VLAN view and range, if any, of the VLAN parent with the given reference.
+func getVlanParentNames(conn ibclient.IBConnector, parentRef string) (viewName, rangeName string, err error) {
+	viewRef := parentRef
+	if strings.HasPrefix(parentRef, "vlanrange/") {
+		vlanRange, err := getVlanRange(conn, parentRef)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read VLAN range '%s': %w", parentRef, err)
+		}
+		if vlanRange.Name != nil {
+			rangeName = *vlanRange.Name
+		}
+		if vlanRange.VlanView == nil {
+			return "", rangeName, nil
+		}
+		viewRef = *vlanRange.VlanView
+	}
+	viewName, err = getVlanViewName(conn, viewRef)
+
+	return viewName, rangeName, err
+}
+
+func resourceVlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parentRef, err := getVlanParentRef(conn, d.Get("vlan_view").(string), d.Get("vlan_range").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vlan := expandVlan(d, extAttrs)
+	vlan.fields["parent"] = parentRef
+	if _, ok := d.GetOk("vlan_id"); !ok {
+		// The next available VLAN ID is allocated by NIOS along with the creation of the VLAN.
+		vlan.fields["id"] = map[string]interface{}{
+			"_object_function": "next_available_vlan_id",
+			"_object_ref":      parentRef,
+			"_result_field":    "vlan_ids",
+			"_parameters":      map[string]interface{}{"num": 1},
+		}
+	}
+
+	ref, err := conn.CreateObject(vlan)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of VLAN '%s' failed: %w", d.Get("name").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceVlanRead(ctx, d, m)
+}
+
+func getVlan(conn ibclient.IBConnector, ref string) (*vlanObject, error) {
+	if !vlanRegExp.MatchString(ref) {
+		return nil, fmt.Errorf("reference '%s' for 'vlan' object has an invalid format", ref)
+	}
+
+	obj := newWapiRawObject("vlan", nil)
+	obj.SetReturnFields(vlanReturnFields)
+	var res vlanObject
+	if err := conn.GetObject(obj, ref, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func resourceVlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	vlan, err := getVlan(conn, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read VLAN '%s': %w", d.Id(), err))
+	}
+
+	res := flattenVlan(*vlan)
+	if res["vlan_view"], res["vlan_range"], err = getVlanParentNames(conn, vlan.Parent); err != nil {
+		return diag.FromErr(err)
+	}
+	for field, value := range res {
+		if err = d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = terraformSetEAs(d, m, vlan.Ea); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ref", vlan.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(vlan.Ref)
+
+	return nil
+}
+
+func resourceVlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cur, err := getVlan(conn, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read VLAN for update operation: %w", err))
+	}
+
+	extAttrs, err := mergeEAs(cur.Ea, newExtAttrs, oldExtAttrs, conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := conn.UpdateObject(expandVlan(d, extAttrs), d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of VLAN '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourceVlanRead(ctx, d, m)
+}
+
+func resourceVlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of VLAN '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceVlanImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	vlan, err := getVlan(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read VLAN: %w", err)
+	}
+
+	if err = terraformImportEAs(d, vlan.Ea); err != nil {
+		return nil, err
+	}
+	d.SetId(vlan.Ref)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenVlan(vlan vlanObject) map[string]interface{} {
+	assignedTo := vlan.AssignedTo
+	if assignedTo == nil {
+		assignedTo = []string{}
+	}
+
+	return map[string]interface{}{
+		"vlan_id":     int(vlan.ID),
+		"name":        vlan.Name,
+		"comment":     vlan.Comment,
+		"contact":     vlan.Contact,
+		"department":  vlan.Department,
+		"description": vlan.Description,
+		"reserved":    vlan.Reserved,
+		"status":      vlan.Status,
+		"assigned_to": assignedTo,
+	}
+}
+
+// vlanLink is the assignment of a VLAN to a network.
+type vlanLink struct {
+	Vlan string `json:"vlan"`
+	ID   uint32 `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+func vlanLinksSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Description: "The VLANs assigned to the network. If not set, the VLANs assigned to the network " +
+			"outside of Terraform are kept.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"vlan": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(vlanRegExp, "must be a reference of a VLAN"),
+					Description:  "The reference of the VLAN.",
+				},
+				"id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The VLAN ID.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the VLAN.",
+				},
+			},
+		},
+	}
+}
+
+func expandVlanLinks(list []interface{}) []vlanLink {
+	res := make([]vlanLink, 0, len(list))
+	for _, v := range list {
+		if m, ok := v.(map[string]interface{}); ok {
+			res = append(res, vlanLink{Vlan: m["vlan"].(string)})
+		}
+	}
+
+	return res
+}
+
+func flattenVlanLinks(links []vlanLink) []interface{} {
+	res := make([]interface{}, 0, len(links))
+	for _, l := range links {
+		res = append(res, map[string]interface{}{
+			"vlan": l.Vlan,
+			"id":   int(l.ID),
+			"name": l.Name,
+		})
+	}
+
+	return res
+}
+
+// getNetworkVlanLinks returns the VLANs assigned to the IPv4 or IPv6 network with the given reference.
+func getNetworkVlanLinks(conn ibclient.IBConnector, ref string) ([]vlanLink, error) {
+	obj := newWapiRawObject(searchObjectType(ref), nil)
+	obj.SetReturnFields([]string{"vlans"})
+	var res struct {
+		Vlans []vlanLink `json:"vlans"`
+	}
+	if err := conn.GetObject(obj, ref, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Vlans, nil
+}
+
+// setNetworkVlanLinks assigns the VLANs to the IPv4 or IPv6 network with the given reference,
+// replacing the VLANs assigned before.
+func setNetworkVlanLinks(conn ibclient.IBConnector, ref string, links []vlanLink) (string, error) {
+	obj := newWapiRawObject(searchObjectType(ref), map[string]interface{}{"vlans": links})
+
+	return conn.UpdateObject(obj, ref)
+}
@@ -0,0 +1,275 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+var (
+	vlanRangeRegExp = regexp.MustCompile("^vlanrange/.+")
+
+	vlanRangeReturnFields = []string{"name", "vlan_view", "start_vlan_id", "end_vlan_id", "comment", "extattrs"}
+)
+
+func resourceVlanRange() *schema.Resource {
+	return withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceVlanRangeCreate,
+		ReadContext:   resourceVlanRangeRead,
+		UpdateContext: resourceVlanRangeUpdate,
+		DeleteContext: resourceVlanRangeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVlanRangeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the VLAN range.",
+			},
+			"vlan_view": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the VLAN view the VLAN range belongs to.",
+			},
+			"start_vlan_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(minVlanID, maxVlanID),
+				Description:  "The first VLAN ID of the VLAN range.",
+			},
+			"end_vlan_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(minVlanID, maxVlanID),
+				Description:  "The last VLAN ID of the VLAN range.",
+			},
+			"pre_create_vlan": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "If true, the VLANs of all the VLAN IDs of the VLAN range are created along with the VLAN range.",
+			},
+			"vlan_name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The prefix of the names of the VLANs created along with the VLAN range.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the VLAN range; maximum 256 characters.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The Extensible attributes of the VLAN range, as a map in JSON format",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	})
+}
+
+func expandVlanRange(d *schema.ResourceData, viewRef string, extAttrs ibclient.EA, isCreate bool) *wapiRawObject {
+	fields := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"start_vlan_id": d.Get("start_vlan_id").(int),
+		"end_vlan_id":   d.Get("end_vlan_id").(int),
+		"comment":       d.Get("comment").(string),
+		"extattrs":      extAttrs,
+	}
+	// The VLAN view and the VLANs to pre-create can be set on creation of the VLAN range only.
+	if isCreate {
+		fields["vlan_view"] = viewRef
+		fields["pre_create_vlan"] = d.Get("pre_create_vlan").(bool)
+		if prefix := d.Get("vlan_name_prefix").(string); prefix != "" {
+			fields["vlan_name_prefix"] = prefix
+		}
+	}
+
+	return newWapiRawObject("vlanrange", fields)
+}
+
+func resourceVlanRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	viewRef, err := getVlanViewRef(conn, d.Get("vlan_view").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := conn.CreateObject(expandVlanRange(d, viewRef, extAttrs, true))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of VLAN range '%s' failed: %w", d.Get("name").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceVlanRangeRead(ctx, d, m)
+}
+
+func getVlanRange(conn ibclient.IBConnector, ref string) (*ibclient.Vlanrange, error) {
+	if !vlanRangeRegExp.MatchString(ref) {
+		return nil, fmt.Errorf("reference '%s' for 'vlanrange' object has an invalid format", ref)
+	}
+
+	vlanRange := &ibclient.Vlanrange{}
+	vlanRange.SetReturnFields(vlanRangeReturnFields)
+	var res ibclient.Vlanrange
+	if err := conn.GetObject(vlanRange, ref, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// getVlanRangeRef returns the reference of the VLAN range with the given name in the VLAN view.
+func getVlanRangeRef(conn ibclient.IBConnector, viewRef, name string) (string, error) {
+	ref, err := getObjectRef(conn, "vlanrange", map[string]string{"name": name, "vlan_view": viewRef})
+	if err != nil {
+		return "", fmt.Errorf("failed to find VLAN range '%s': %w", name, err)
+	}
+
+	return ref, nil
+}
+
+// getVlanViewName returns the name of the VLAN view with the given reference.
+func getVlanViewName(conn ibclient.IBConnector, ref string) (string, error) {
+	view, err := getVlanView(conn, ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to read VLAN view '%s': %w", ref, err)
+	}
+	if view.Name == nil {
+		return "", nil
+	}
+
+	return *view.Name, nil
+}
+
+func resourceVlanRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	vlanRange, err := getVlanRange(conn, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read VLAN range '%s': %w", d.Id(), err))
+	}
+
+	res := flattenVlanRange(*vlanRange)
+	if vlanRange.VlanView != nil {
+		if res["vlan_view"], err = getVlanViewName(conn, *vlanRange.VlanView); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	for field, value := range res {
+		if err = d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = terraformSetEAs(d, m, vlanRange.Ea); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ref", vlanRange.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(vlanRange.Ref)
+
+	return nil
+}
+
+func resourceVlanRangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cur, err := getVlanRange(conn, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read VLAN range for update operation: %w", err))
+	}
+
+	extAttrs, err := mergeEAs(cur.Ea, newExtAttrs, oldExtAttrs, conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := conn.UpdateObject(expandVlanRange(d, "", extAttrs, false), d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of VLAN range '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourceVlanRangeRead(ctx, d, m)
+}
+
+func resourceVlanRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of VLAN range '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceVlanRangeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	vlanRange, err := getVlanRange(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read VLAN range: %w", err)
+	}
+
+	if err = terraformImportEAs(d, vlanRange.Ea); err != nil {
+		return nil, err
+	}
+	d.SetId(vlanRange.Ref)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenVlanRange(vlanRange ibclient.Vlanrange) map[string]interface{} {
+	res := map[string]interface{}{
+		"name":          "",
+		"start_vlan_id": 0,
+		"end_vlan_id":   0,
+		"comment":       "",
+	}
+	if vlanRange.Name != nil {
+		res["name"] = *vlanRange.Name
+	}
+	if vlanRange.StartVlanId != nil {
+		res["start_vlan_id"] = int(*vlanRange.StartVlanId)
+	}
+	if vlanRange.EndVlanId != nil {
+		res["end_vlan_id"] = int(*vlanRange.EndVlanId)
+	}
+	if vlanRange.Comment != nil {
+		res["comment"] = *vlanRange.Comment
+	}
+
+	return res
+}
@@ -0,0 +1,74 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"testing"
+)
+
+func testAccCheckVlanRangeDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_vlan_range", func() ibclient.IBObject {
+		return &ibclient.Vlanrange{}
+	})(s)
+}
+
+func TestAcc_resourceVlanRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVlanRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_vlan_view" "view" {
+						name = "tf_acc_test_vlan_range_view"
+						start_vlan_id = 1
+						end_vlan_id = 1000
+					}
+
+					resource "infoblox_vlan_range" "range" {
+						name = "tf_acc_test_vlan_range"
+						vlan_view = infoblox_vlan_view.view.name
+						start_vlan_id = 100
+						end_vlan_id = 199
+						pre_create_vlan = true
+						vlan_name_prefix = "tf-acc-"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_vlan_range.range", "vlan_view", "tf_acc_test_vlan_range_view"),
+					resource.TestCheckResourceAttr("infoblox_vlan_range.range", "start_vlan_id", "100"),
+					resource.TestCheckResourceAttr("infoblox_vlan_range.range", "end_vlan_id", "199"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_vlan_view" "view" {
+						name = "tf_acc_test_vlan_range_view"
+						start_vlan_id = 1
+						end_vlan_id = 1000
+					}
+
+					resource "infoblox_vlan_range" "range" {
+						name = "tf_acc_test_vlan_range_renamed"
+						vlan_view = infoblox_vlan_view.view.name
+						start_vlan_id = 100
+						end_vlan_id = 199
+						pre_create_vlan = true
+						vlan_name_prefix = "tf-acc-"
+						comment = "updated"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_vlan_range.range", "name", "tf_acc_test_vlan_range_renamed"),
+					resource.TestCheckResourceAttr("infoblox_vlan_range.range", "comment", "updated"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_vlan_range.range",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pre_create_vlan", "vlan_name_prefix"},
+			},
+		},
+	})
+}
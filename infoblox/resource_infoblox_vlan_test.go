@@ -0,0 +1,137 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"testing"
+)
+
+func testAccCheckVlanDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_vlan", func() ibclient.IBObject {
+		return newWapiRawObject("vlan", nil)
+	})(s)
+}
+
+func TestExpandFlattenVlanLinks(t *testing.T) {
+	links := expandVlanLinks([]interface{}{
+		map[string]interface{}{"vlan": "vlan/ZG5zLnZsYW4kLmNvbS5pbmZvYmxveC5kbnMudmxhbl92aWV3JHZpZXcuMS4xMDAuMTA:view/vlan1/10", "id": 0, "name": ""},
+	})
+	if len(links) != 1 || links[0].ID != 0 || links[0].Name != "" {
+		t.Fatalf("expected a single VLAN link without ID and name, got %v", links)
+	}
+
+	links[0].ID, links[0].Name = 10, "vlan1"
+	flattened := flattenVlanLinks(links)
+	if len(flattened) != 1 {
+		t.Fatalf("expected a single VLAN block, got %v", flattened)
+	}
+	block := flattened[0].(map[string]interface{})
+	if block["vlan"] != links[0].Vlan || block["id"] != 10 || block["name"] != "vlan1" {
+		t.Errorf("unexpected VLAN block: %v", block)
+	}
+}
+
+func TestAcc_resourceVlan(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_vlan_view" "view" {
+						name = "tf_acc_test_vlan_view_vlans"
+						start_vlan_id = 1
+						end_vlan_id = 1000
+					}
+
+					resource "infoblox_vlan_range" "range" {
+						name = "tf_acc_test_vlan_range_vlans"
+						vlan_view = infoblox_vlan_view.view.name
+						start_vlan_id = 300
+						end_vlan_id = 399
+					}
+
+					resource "infoblox_vlan" "next" {
+						name = "tf-acc-next"
+						vlan_view = infoblox_vlan_view.view.name
+						vlan_range = infoblox_vlan_range.range.name
+					}
+
+					resource "infoblox_vlan" "static" {
+						name = "tf-acc-static"
+						vlan_view = infoblox_vlan_view.view.name
+						vlan_id = 10
+						department = "Testing"
+					}
+
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.40.0.0/24"
+						vlans {
+							vlan = infoblox_vlan.next.ref
+						}
+						vlans {
+							vlan = infoblox_vlan.static.ref
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_vlan.next", "vlan_id", "300"),
+					resource.TestCheckResourceAttr("infoblox_vlan.next", "vlan_range", "tf_acc_test_vlan_range_vlans"),
+					resource.TestCheckResourceAttr("infoblox_vlan.static", "vlan_id", "10"),
+					resource.TestCheckResourceAttr("infoblox_vlan.static", "vlan_range", ""),
+					resource.TestCheckResourceAttr("infoblox_vlan.static", "department", "Testing"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "vlans.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "vlans.0.id", "300"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "vlans.1.name", "tf-acc-static"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_vlan_view" "view" {
+						name = "tf_acc_test_vlan_view_vlans"
+						start_vlan_id = 1
+						end_vlan_id = 1000
+					}
+
+					resource "infoblox_vlan_range" "range" {
+						name = "tf_acc_test_vlan_range_vlans"
+						vlan_view = infoblox_vlan_view.view.name
+						start_vlan_id = 300
+						end_vlan_id = 399
+					}
+
+					resource "infoblox_vlan" "next" {
+						name = "tf-acc-next"
+						vlan_view = infoblox_vlan_view.view.name
+						vlan_range = infoblox_vlan_range.range.name
+					}
+
+					resource "infoblox_vlan" "static" {
+						name = "tf-acc-static"
+						vlan_view = infoblox_vlan_view.view.name
+						vlan_id = 11
+					}
+
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.40.0.0/24"
+						vlans {
+							vlan = infoblox_vlan.static.ref
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_vlan.next", "vlan_id", "300"),
+					resource.TestCheckResourceAttr("infoblox_vlan.static", "vlan_id", "11"),
+					resource.TestCheckResourceAttr("infoblox_vlan.static", "department", ""),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "vlans.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "vlans.0.id", "11"),
+				),
+			},
+			{
+				ResourceName:      "infoblox_vlan.static",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,259 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"regexp"
+)
+
+// The range of valid VLAN IDs.
+const (
+	minVlanID = 1
+	maxVlanID = 4094
+)
+
+var (
+	vlanViewRegExp = regexp.MustCompile("^vlanview/.+")
+
+	vlanViewReturnFields = []string{
+		"name", "start_vlan_id", "end_vlan_id", "allow_range_overlapping", "comment", "extattrs"}
+)
+
+func resourceVlanView() *schema.Resource {
+	return withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceVlanViewCreate,
+		ReadContext:   resourceVlanViewRead,
+		UpdateContext: resourceVlanViewUpdate,
+		DeleteContext: resourceVlanViewDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVlanViewImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the VLAN view.",
+			},
+			"start_vlan_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(minVlanID, maxVlanID),
+				Description:  "The first VLAN ID of the VLAN view.",
+			},
+			"end_vlan_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(minVlanID, maxVlanID),
+				Description:  "The last VLAN ID of the VLAN view.",
+			},
+			"allow_range_overlapping": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the VLAN ranges of the VLAN view may have overlapping VLAN IDs.",
+			},
+			"pre_create_vlan": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "If true, the VLANs of all the VLAN IDs of the VLAN view are created along with the VLAN view.",
+			},
+			"vlan_name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The prefix of the names of the VLANs created along with the VLAN view.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment for the VLAN view; maximum 256 characters.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The Extensible attributes of the VLAN view, as a map in JSON format",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	})
+}
+
+func expandVlanView(d *schema.ResourceData, extAttrs ibclient.EA, isCreate bool) *wapiRawObject {
+	fields := map[string]interface{}{
+		"name":                    d.Get("name").(string),
+		"start_vlan_id":           d.Get("start_vlan_id").(int),
+		"end_vlan_id":             d.Get("end_vlan_id").(int),
+		"allow_range_overlapping": d.Get("allow_range_overlapping").(bool),
+		"comment":                 d.Get("comment").(string),
+		"extattrs":                extAttrs,
+	}
+	// The VLANs are pre-created on creation of the VLAN view only.
+	if isCreate {
+		fields["pre_create_vlan"] = d.Get("pre_create_vlan").(bool)
+		if prefix := d.Get("vlan_name_prefix").(string); prefix != "" {
+			fields["vlan_name_prefix"] = prefix
+		}
+	}
+
+	return newWapiRawObject("vlanview", fields)
+}
+
+func resourceVlanViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	conn := m.(ibclient.IBConnector)
+	ref, err := conn.CreateObject(expandVlanView(d, extAttrs, true))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of VLAN view '%s' failed: %w", d.Get("name").(string), err))
+	}
+	d.SetId(ref)
+
+	return resourceVlanViewRead(ctx, d, m)
+}
+
+func getVlanView(conn ibclient.IBConnector, ref string) (*ibclient.Vlanview, error) {
+	if !vlanViewRegExp.MatchString(ref) {
+		return nil, fmt.Errorf("reference '%s' for 'vlanview' object has an invalid format", ref)
+	}
+
+	view := &ibclient.Vlanview{}
+	view.SetReturnFields(vlanViewReturnFields)
+	var res ibclient.Vlanview
+	if err := conn.GetObject(view, ref, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// getVlanViewRef returns the reference of the VLAN view with the given name.
+func getVlanViewRef(conn ibclient.IBConnector, name string) (string, error) {
+	ref, err := getObjectRef(conn, "vlanview", map[string]string{"name": name})
+	if err != nil {
+		return "", fmt.Errorf("failed to find VLAN view '%s': %w", name, err)
+	}
+
+	return ref, nil
+}
+
+func resourceVlanViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	view, err := getVlanView(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read VLAN view '%s': %w", d.Id(), err))
+	}
+
+	for field, value := range flattenVlanView(*view) {
+		if err = d.Set(field, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = terraformSetEAs(d, m, view.Ea); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ref", view.Ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(view.Ref)
+
+	return nil
+}
+
+func resourceVlanViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cur, err := getVlanView(conn, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read VLAN view for update operation: %w", err))
+	}
+
+	extAttrs, err := mergeEAs(cur.Ea, newExtAttrs, oldExtAttrs, conn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, err := conn.UpdateObject(expandVlanView(d, extAttrs, false), d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("update of VLAN view '%s' failed: %w", d.Id(), err))
+	}
+	d.SetId(ref)
+
+	return resourceVlanViewRead(ctx, d, m)
+}
+
+func resourceVlanViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of VLAN view '%s' failed: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceVlanViewImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	view, err := getVlanView(m.(ibclient.IBConnector), d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read VLAN view: %w", err)
+	}
+
+	if err = terraformImportEAs(d, view.Ea); err != nil {
+		return nil, err
+	}
+	d.SetId(view.Ref)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenVlanView(view ibclient.Vlanview) map[string]interface{} {
+	res := map[string]interface{}{
+		"name":                    "",
+		"start_vlan_id":           0,
+		"end_vlan_id":             0,
+		"allow_range_overlapping": false,
+		"comment":                 "",
+	}
+	if view.Name != nil {
+		res["name"] = *view.Name
+	}
+	if view.StartVlanId != nil {
+		res["start_vlan_id"] = int(*view.StartVlanId)
+	}
+	if view.EndVlanId != nil {
+		res["end_vlan_id"] = int(*view.EndVlanId)
+	}
+	if view.AllowRangeOverlapping != nil {
+		res["allow_range_overlapping"] = *view.AllowRangeOverlapping
+	}
+	if view.Comment != nil {
+		res["comment"] = *view.Comment
+	}
+
+	return res
+}
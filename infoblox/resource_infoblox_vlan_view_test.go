@@ -0,0 +1,62 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"testing"
+)
+
+func testAccCheckVlanViewDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_vlan_view", func() ibclient.IBObject {
+		return &ibclient.Vlanview{}
+	})(s)
+}
+
+func TestAcc_resourceVlanView(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVlanViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_vlan_view" "view" {
+						name = "tf_acc_test_vlan_view"
+						start_vlan_id = 1
+						end_vlan_id = 100
+						comment = "VLAN view created by acceptance tests"
+						ext_attrs = jsonencode({
+							"Site" = "Test site"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_vlan_view.view", "name", "tf_acc_test_vlan_view"),
+					resource.TestCheckResourceAttr("infoblox_vlan_view.view", "start_vlan_id", "1"),
+					resource.TestCheckResourceAttr("infoblox_vlan_view.view", "end_vlan_id", "100"),
+					resource.TestCheckResourceAttr("infoblox_vlan_view.view", "allow_range_overlapping", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_vlan_view" "view" {
+						name = "tf_acc_test_vlan_view"
+						start_vlan_id = 1
+						end_vlan_id = 200
+						allow_range_overlapping = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_vlan_view.view", "end_vlan_id", "200"),
+					resource.TestCheckResourceAttr("infoblox_vlan_view.view", "allow_range_overlapping", "true"),
+					resource.TestCheckResourceAttr("infoblox_vlan_view.view", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "infoblox_vlan_view.view",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pre_create_vlan"},
+			},
+		},
+	})
+}
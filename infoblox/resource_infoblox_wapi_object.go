@@ -0,0 +1,352 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"reflect"
+	"strings"
+)
+
+// The infoblox_wapi_object resource manages NIOS objects of the types not supported by the other resources.
+// The fields of an object are passed to WAPI as they are, so the resource relies on NIOS to validate them.
+
+func resourceWapiObject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWapiObjectCreate,
+		ReadContext:   resourceWapiObjectRead,
+		UpdateContext: resourceWapiObjectUpdate,
+		DeleteContext: resourceWapiObjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWapiObjectImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The WAPI type of the object, for example 'bulkhost' or 'record:caa'.",
+			},
+			"fields": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+				Description:      "The fields of the object in WAPI format, as a map in JSON format.",
+			},
+			"tracked_fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The fields read back from NIOS to detect changes made outside of Terraform; " +
+					"all the fields given in 'fields' by default. Write-only fields, like passwords, must not be tracked.",
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource; empty if the object type does not support" +
+					" extensible attributes.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// suppressEquivalentJSON suppresses the differences between semantically equal JSON documents.
+func suppressEquivalentJSON(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	var oldDoc, newDoc interface{}
+	if err := json.Unmarshal([]byte(oldValue), &oldDoc); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(newValue), &newDoc); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldDoc, newDoc)
+}
+
+// wapiSchemaField is the description of a field in the WAPI schema of an object type.
+type wapiSchemaField struct {
+	Name          string `json:"name"`
+	StandardField bool   `json:"standard_field"`
+	SearchableBy  string `json:"searchable_by"`
+}
+
+// getWapiSchemaFields returns the descriptions of the fields of the given object type.
+func getWapiSchemaFields(conn ibclient.IBConnector, objType string) ([]wapiSchemaField, error) {
+	var res struct {
+		Fields []wapiSchemaField `json:"fields"`
+	}
+	qp := ibclient.NewQueryParams(false, map[string]string{"_schema": "1", "_schema_version": "2"})
+	if err := conn.GetObject(newWapiRawObject(objType, nil), "", qp, &res); err != nil {
+		return nil, fmt.Errorf("failed to read the schema of '%s' object type: %w", objType, err)
+	}
+
+	return res.Fields, nil
+}
+
+// wapiObjectSupportsEAs checks whether the objects of the given type have extensible attributes.
+func wapiObjectSupportsEAs(conn ibclient.IBConnector, objType string) (bool, error) {
+	fields, err := getWapiSchemaFields(conn, objType)
+	if err != nil {
+		return false, err
+	}
+
+	return wapiSchemaHasField(fields, "extattrs"), nil
+}
+
+func wapiSchemaHasField(fields []wapiSchemaField, name string) bool {
+	for _, f := range fields {
+		if f.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandWapiObjectFields(d *schema.ResourceData) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(d.Get("fields").(string)), &fields); err != nil {
+		return nil, fmt.Errorf("cannot process 'fields' field: %w", err)
+	}
+
+	return fields, nil
+}
+
+// withInternalIdEA adds the Terraform Internal ID extensible attribute to the extensible attributes
+// of the object in WAPI format.
+func withInternalIdEA(fields map[string]interface{}, internalId string) {
+	extAttrs, _ := fields["extattrs"].(map[string]interface{})
+	if extAttrs == nil {
+		extAttrs = make(map[string]interface{})
+	}
+	extAttrs[eaNameForInternalId] = map[string]interface{}{"value": internalId}
+	fields["extattrs"] = extAttrs
+}
+
+func resourceWapiObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+	objType := d.Get("object_type").(string)
+
+	fields, err := expandWapiObjectFields(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	supportsEAs, err := wapiObjectSupportsEAs(conn, objType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	internalId := ""
+	if supportsEAs {
+		internalId = generateInternalId().String()
+		withInternalIdEA(fields, internalId)
+	}
+
+	ref, err := conn.CreateObject(newWapiRawObject(objType, fields))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creation of '%s' object failed: %w", objType, withWapiErrorAttribute(err, "fields")))
+	}
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceWapiObjectRead(ctx, d, m)
+}
+
+// getWapiObject reads the given fields of the object with the given reference or, if the object is not found
+// and the internal ID is given, of the object with the internal ID.
+func getWapiObject(conn ibclient.IBConnector, objType, ref, internalId string, returnFields []string) (map[string]interface{}, error) {
+	obj := newWapiRawObject(objType, nil)
+	obj.SetReturnFields(returnFields)
+
+	var res map[string]interface{}
+	err := conn.GetObject(obj, ref, ibclient.NewQueryParams(false, nil), &res)
+	if err == nil || !isNotFoundError(err) || internalId == "" {
+		return res, err
+	}
+
+	// The reference of the object may have been changed outside of Terraform.
+	var found []map[string]interface{}
+	sf := map[string]string{"*" + eaNameForInternalId: internalId}
+	if err = conn.GetObject(obj, "", ibclient.NewQueryParams(false, sf), &found); err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, ibclient.NewNotFoundError(fmt.Sprintf("'%s' object not found", objType))
+	}
+
+	return found[0], nil
+}
+
+func resourceWapiObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+	objType := d.Get("object_type").(string)
+	internalId := d.Get("internal_id").(string)
+
+	fields, err := expandWapiObjectFields(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Only the fields given in 'fields' are tracked, the other fields of the object are not managed by the resource.
+	var tracked []string
+	if trackedFields := expandStringList(d.Get("tracked_fields").([]interface{})); len(trackedFields) > 0 {
+		for _, field := range trackedFields {
+			if _, ok := fields[field]; ok {
+				tracked = append(tracked, field)
+			}
+		}
+	} else {
+		for field := range fields {
+			tracked = append(tracked, field)
+		}
+	}
+	returnFields := tracked
+	if internalId != "" && !containsAny(tracked, []string{"extattrs"}) {
+		returnFields = append(append([]string{}, tracked...), "extattrs")
+	}
+
+	obj, err := getWapiObject(conn, objType, d.Id(), internalId, returnFields)
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read '%s' object '%s': %w", objType, d.Id(), err))
+	}
+
+	for _, field := range tracked {
+		value, ok := obj[field]
+		if !ok {
+			continue
+		}
+		if extAttrs, isMap := value.(map[string]interface{}); field == "extattrs" && isMap {
+			delete(extAttrs, eaNameForInternalId)
+		}
+		fields[field] = value
+	}
+	fieldsJSON, err := json.Marshal(fields)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("fields", string(fieldsJSON)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref, _ := obj["_ref"].(string)
+	if err = d.Set("ref", ref); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(ref)
+
+	return nil
+}
+
+func resourceWapiObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+	objType := d.Get("object_type").(string)
+
+	if d.HasChange("fields") {
+		fields, err := expandWapiObjectFields(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// Unless the extensible attributes are updated, the Terraform Internal ID is kept as it is.
+		if internalId := d.Get("internal_id").(string); internalId != "" {
+			if _, ok := fields["extattrs"]; ok {
+				withInternalIdEA(fields, internalId)
+			}
+		}
+
+		ref, err := conn.UpdateObject(newWapiRawObject(objType, fields), d.Id())
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("update of '%s' object '%s' failed: %w", objType, d.Id(), withWapiErrorAttribute(err, "fields")))
+		}
+		d.SetId(ref)
+	}
+
+	return resourceWapiObjectRead(ctx, d, m)
+}
+
+func resourceWapiObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	conn := m.(ibclient.IBConnector)
+
+	if _, err := conn.DeleteObject(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deletion of '%s' object '%s' failed: %w", d.Get("object_type").(string), d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceWapiObjectImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	conn := m.(ibclient.IBConnector)
+	ref := d.Id()
+	objType := strings.SplitN(ref, "/", 2)[0]
+	if objType == ref {
+		return nil, fmt.Errorf("reference '%s' has an invalid format", ref)
+	}
+
+	supportsEAs, err := wapiObjectSupportsEAs(conn, objType)
+	if err != nil {
+		return nil, err
+	}
+	var returnFields []string
+	if supportsEAs {
+		returnFields = []string{"extattrs"}
+	}
+	obj, err := getWapiObject(conn, objType, ref, "", returnFields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read '%s' object: %w", objType, err)
+	}
+	ref, _ = obj["_ref"].(string)
+
+	internalId := ""
+	if supportsEAs {
+		internalId = internalIdOfWapiObject(obj)
+		if internalId == "" {
+			// The Terraform Internal ID is added to the extensible attributes the object already has.
+			internalId = generateInternalId().String()
+			fields := map[string]interface{}{
+				"extattrs+": map[string]interface{}{
+					eaNameForInternalId: map[string]interface{}{"value": internalId},
+				},
+			}
+			if ref, err = conn.UpdateObject(newWapiRawObject(objType, fields), ref); err != nil {
+				return nil, fmt.Errorf("failed to set the internal ID of '%s' object: %w", objType, err)
+			}
+		}
+	}
+
+	// The fields are taken from the configuration by the next plan, so that only the configured fields are tracked.
+	if err = d.Set("object_type", objType); err != nil {
+		return nil, err
+	}
+	if err = d.Set("fields", "{}"); err != nil {
+		return nil, err
+	}
+	if err = d.Set("internal_id", internalId); err != nil {
+		return nil, err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return nil, err
+	}
+	d.SetId(ref)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,154 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"reflect"
+	"testing"
+)
+
+func testAccCheckWapiObjectDestroy(s *terraform.State) error {
+	return testAccCheckObjectsDestroyed("infoblox_wapi_object", func() ibclient.IBObject {
+		return newWapiRawObject("namedacl", nil)
+	})(s)
+}
+
+func TestAcc_resourceWapiObject(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWapiObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_wapi_object" "acl" {
+						object_type = "namedacl"
+						fields = jsonencode({
+							name = "tf_acc_test_wapi_object"
+							comment = "named ACL created by acceptance tests"
+							access_list = [{
+								_struct = "addressac"
+								address = "10.0.0.0/8"
+								permission = "ALLOW"
+							}]
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_wapi_object.acl", "object_type", "namedacl"),
+					resource.TestCheckResourceAttrSet("infoblox_wapi_object.acl", "internal_id"),
+					resource.TestCheckResourceAttrPair("infoblox_wapi_object.acl", "ref", "infoblox_wapi_object.acl", "id"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_wapi_object" "acl" {
+						object_type = "namedacl"
+						fields = jsonencode({
+							name = "tf_acc_test_wapi_object"
+							comment = "updated comment"
+							extattrs = {
+								Site = { value = "Test site" }
+							}
+						})
+						tracked_fields = ["name", "comment", "extattrs"]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_wapi_object.acl", "fields",
+						`{"comment":"updated comment","extattrs":{"Site":{"value":"Test site"}},"name":"tf_acc_test_wapi_object"}`),
+				),
+			},
+			{
+				ResourceName:            "infoblox_wapi_object.acl",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fields", "tracked_fields"},
+			},
+		},
+	})
+}
+
+func TestWithInternalIdEA(t *testing.T) {
+	fields := map[string]interface{}{
+		"name":     "acl",
+		"extattrs": map[string]interface{}{"Site": map[string]interface{}{"value": "Test site"}},
+	}
+	withInternalIdEA(fields, "id1")
+
+	expected := map[string]interface{}{
+		"Site":              map[string]interface{}{"value": "Test site"},
+		eaNameForInternalId: map[string]interface{}{"value": "id1"},
+	}
+	if !reflect.DeepEqual(fields["extattrs"], expected) {
+		t.Errorf("unexpected extensible attributes: %v", fields["extattrs"])
+	}
+
+	fields = map[string]interface{}{}
+	withInternalIdEA(fields, "id2")
+	if _, ok := fields["extattrs"].(map[string]interface{})[eaNameForInternalId]; !ok {
+		t.Errorf("the internal ID is not added to the object without extensible attributes")
+	}
+}
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	if !suppressEquivalentJSON("fields", `{"a":1,"b":[1,2]}`, `{ "b": [1, 2], "a": 1 }`, nil) {
+		t.Errorf("equivalent JSON documents are reported as different")
+	}
+	if suppressEquivalentJSON("fields", `{"a":1}`, `{"a":2}`, nil) {
+		t.Errorf("different JSON documents are reported as equivalent")
+	}
+}
+
+// testImportConnector serves the WAPI schema with the extensible attributes and an object without them.
+type testImportConnector struct {
+	ibclient.IBConnector
+
+	updates []string
+}
+
+func (c *testImportConnector) GetObject(
+	obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+
+	data := `{"_ref": "record:caa/ZG5z:test.com/default", "extattrs": {"Site": {"value": "HQ"}}}`
+	if obj.ObjectType() == "record:caa" && ref == "" {
+		data = `{"fields": [{"name": "name"}, {"name": "extattrs"}]}`
+	}
+
+	return json.Unmarshal([]byte(data), res)
+}
+
+func (c *testImportConnector) UpdateObject(obj ibclient.IBObject, ref string) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	c.updates = append(c.updates, string(data))
+
+	return ref, nil
+}
+
+func TestResourceWapiObjectImport(t *testing.T) {
+	ref := "record:caa/ZG5z:test.com/default"
+	conn := &testImportConnector{}
+	r := resourceWapiObject()
+	d := r.Data(&terraform.InstanceState{ID: ref})
+
+	res, err := resourceWapiObjectImport(context.Background(), d, conn)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	d = res[0]
+	if d.Id() != ref || d.Get("ref") != ref || d.Get("object_type") != "record:caa" {
+		t.Errorf("unexpected imported object: ID '%s', ref '%s', type '%s'", d.Id(), d.Get("ref"), d.Get("object_type"))
+	}
+	if d.Get("fields") != "{}" {
+		t.Errorf("expected the fields to be left to the configuration, got '%s'", d.Get("fields"))
+	}
+	expected := fmt.Sprintf(`{"extattrs+":{"Terraform Internal ID":{"value":"%s"}}}`, d.Get("internal_id"))
+	if d.Get("internal_id") == "" || len(conn.updates) != 1 || conn.updates[0] != expected {
+		t.Errorf("expected the internal ID to be added to the object, got %v", conn.updates)
+	}
+}
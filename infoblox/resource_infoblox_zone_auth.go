@@ -11,14 +11,22 @@ import (
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+var zoneAuthAccessControlFields = []string{"allow_query", "allow_transfer", "allow_update"}
+
+// zoneAuthNaturalKey maps the arguments identifying the authoritative zones to the WAPI search fields.
+var zoneAuthNaturalKey = map[string]string{
+	"fqdn": "fqdn",
+	"view": "view",
+}
+
 func resourceZoneAuth() *schema.Resource {
-	return &schema.Resource{
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withExtensibleAttributes(&schema.Resource{
 		CreateContext: resourceZoneAuthCreate,
 		ReadContext:   resourceZoneAuthRead,
 		UpdateContext: resourceZoneAuthUpdate,
 		DeleteContext: resourceZoneAuthDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceZoneAuthImport,
+			StateContext: resourceZoneAuthImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -66,6 +74,18 @@ func resourceZoneAuth() *schema.Resource {
 				Description: "The Extensible attributes of the zone, as a map in JSON format",
 			},
 
+			"allow_query": accessControlListSchema(
+				"The ordered list of access control entries which determine the clients allowed to query the zone. " +
+					"If empty, the setting is inherited from the DNS member or the grid."),
+
+			"allow_transfer": accessControlListSchema(
+				"The ordered list of access control entries which determine the clients allowed to transfer the zone. " +
+					"If empty, the setting is inherited from the DNS member or the grid."),
+
+			"allow_update": accessControlListSchema(
+				"The ordered list of access control entries which determine the clients allowed to send " +
+					"dynamic DNS updates for the zone. If empty, the setting is inherited from the DNS member or the grid."),
+
 			"ns_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -133,7 +153,7 @@ func resourceZoneAuth() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
+	}), "fqdn", "zone_format", "view"), "zone_auth", zoneAuthNaturalKey), zoneAuthNaturalKey)
 }
 
 func checkZoneFormat(f string) diag.Diagnostics {
@@ -149,8 +169,7 @@ func formZone(
 	create bool, d *schema.ResourceData, m interface{}) (
 	*ibclient.ZoneAuth, diag.Diagnostics) {
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
@@ -231,8 +250,17 @@ func resourceZoneAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 	internalId := generateInternalId()
 	zone.Ea[eaNameForInternalId] = internalId.String()
 
+	acls, err := changedAccessControlLists(d, zoneAuthAccessControlFields, true, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneObj, err := withWapiFields(zone, acls)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	connector := m.(ibclient.IBConnector)
-	zoneRef, err := connector.CreateObject(zone)
+	zoneRef, err := connector.CreateObject(zoneObj)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create a zone: %w", err))
 	}
@@ -251,12 +279,6 @@ func resourceZoneAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	var diags diag.Diagnostics
 
 	rec, err := searchObjectByRefOrInternalId("ZoneAuth", d, m)
@@ -347,18 +369,14 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	delete(zoneResult.Ea, eaNameForInternalId)
+	if err = readAccessControlLists(d, m, "zone_auth", zoneResult.Ref, zoneAuthAccessControlFields); err != nil {
+		return diag.FromErr(err)
+	}
 
-	omittedEAs := omitEAs(zoneResult.Ea, extAttrs)
+	delete(zoneResult.Ea, eaNameForInternalId)
 
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return diag.FromErr(err)
-		}
+	if err = terraformSetEAs(d, m, zoneResult.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(zoneResult.Ref)
@@ -370,35 +388,12 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-	if d.HasChange("internal_id") {
-		return diag.FromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
-	}
-
-	if d.HasChange("fqdn") {
-		return diag.FromErr(fmt.Errorf("field is not allowed for update: fqdn"))
-	}
-
-	if d.HasChange("zone_format") {
-		return diag.FromErr(fmt.Errorf("field is not allowed for update: zone_format"))
-	}
-
-	if d.HasChange("view") {
-		return diag.FromErr(fmt.Errorf("field is not allowed for update: view"))
-	}
-
 	zone, errs := formZone(false, d, m)
 	if errs != nil {
 		return errs
 	}
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -439,7 +434,16 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	zoneRef, err := connector.UpdateObject(zone, d.Id())
+	acls, err := changedAccessControlLists(d, zoneAuthAccessControlFields, false, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneObj, err := withWapiFields(zone, acls)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneRef, err := connector.UpdateObject(zoneObj, d.Id())
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update a zone: %w", err))
 	}
@@ -452,6 +456,7 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
 		return diag.FromErr(err)
 	}
+
 	return resourceZoneAuthRead(ctx, d, m)
 }
 
@@ -486,9 +491,8 @@ func resourceZoneAuthDelete(ctx context.Context, d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceZoneAuthImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+func resourceZoneAuthImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	_, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -585,23 +589,14 @@ func resourceZoneAuthImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 		return nil, err
 	}
 
-	if zoneResult.Ea != nil && len(zoneResult.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(zoneResult.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, zoneResult.Ea); err != nil {
+		return nil, err
 	}
 
 	d.SetId(zoneResult.Ref)
 
-	var dErr diag.Diagnostics
-	var ctx context.Context
-	dErr = resourceZoneAuthUpdate(ctx, d, m)
-	if dErr != nil {
-		return nil, fmt.Errorf("failed to import Zone Auth: %v", dErr)
+	if diags := resourceZoneAuthUpdate(ctx, d, m); diags.HasError() {
+		return nil, fmt.Errorf("failed to import Zone Auth: %w", diagnosticsError(diags))
 	}
 
 	return []*schema.ResourceData{d}, nil
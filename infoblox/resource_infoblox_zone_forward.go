@@ -4,18 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+// zoneForwardNaturalKey maps the arguments identifying the forward zones to the WAPI search fields.
+var zoneForwardNaturalKey = map[string]string{
+	"fqdn": "fqdn",
+	"view": "view",
+}
+
 func resourceZoneForward() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceZoneForwardCreate,
-		Read:   resourceZoneForwardRead,
-		Update: resourceZoneForwardUpdate,
-		Delete: resourceZoneForwardDelete,
+	return withWapiFieldAttributes(withAdoptExisting(withImmutableFields(withExtensibleAttributes(&schema.Resource{
+		CreateContext: resourceZoneForwardCreate,
+		ReadContext:   resourceZoneForwardRead,
+		UpdateContext: resourceZoneForwardUpdate,
+		DeleteContext: resourceZoneForwardDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceZoneForwardImport,
+			StateContext: resourceZoneForwardImport,
 		},
 		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
@@ -154,14 +161,13 @@ func resourceZoneForward() *schema.Resource {
 				Description: "NIOS object's reference, not to be set by a user.",
 			},
 		},
-	}
-
+	}), "fqdn", "view", "zone_format"), "zone_forward", zoneForwardNaturalKey), zoneForwardNaturalKey)
 }
 
-func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
+func resourceZoneForwardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
-		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
 	}
 
 	_, externalNsGroupOk := d.GetOk("external_ns_group")
@@ -171,18 +177,18 @@ func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
 	var forwardTo []ibclient.NameServer
 	var nullFWT ibclient.NullableNameServers
 	if !externalNsGroupOk && !forwardToOk {
-		return fmt.Errorf("either external_ns_group or forward_to must be set")
+		return diag.FromErr(fmt.Errorf("either external_ns_group or forward_to must be set"))
 	} else if !forwardToOk {
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: []ibclient.NameServer{}}
 	} else {
 		ftSlice, ok := ftInterface.([]interface{})
 		if !ok {
-			return fmt.Errorf("forward_to is not a slice of Nameservers")
+			return diag.FromErr(fmt.Errorf("forward_to is not a slice of Nameservers"))
 		}
 		var err error
 		forwardTo, err = validateNameServers(ftSlice)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: forwardTo}
 	}
@@ -202,21 +208,20 @@ func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
 	if forwardingServersOk {
 		fsSlice, ok := fsInterface.([]interface{})
 		if !ok {
-			return fmt.Errorf("forwarding_servers is not a slice of Forwardingmemberserver pointers")
+			return diag.FromErr(fmt.Errorf("forwarding_servers is not a slice of Forwardingmemberserver pointers"))
 		}
 		forwardingServer, err := validateForwardingServers(fsSlice)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		nullFWS = &ibclient.NullableForwardingServers{IsNull: false, Servers: forwardingServer}
 	} else if !forwardingServersOk {
 		nullFWS = &ibclient.NullableForwardingServers{IsNull: false, Servers: []*ibclient.Forwardingmemberserver{}}
 	}
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	extAttrs, err := terraformGetEAsWithDefaults(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Generate internal ID and add it to the extensible attributes
@@ -233,16 +238,16 @@ func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
 
 	newForwardZone, err := objMgr.CreateZoneForward(comment, disable, extAttrs, nullFWT, forwardersOnly, nullFWS, fqdn, nsGroup, view, zoneFormat, externalNsGroup)
 	if err != nil {
-		return fmt.Errorf("failed to create zone forward : %s", err)
+		return diag.FromErr(fmt.Errorf("failed to create zone forward : %s", err))
 	}
 	d.SetId(newForwardZone.Ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", newForwardZone.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceZoneForwardRead(d, m)
+	return resourceZoneForwardRead(ctx, d, m)
 }
 
 func validateForwardingServers(fsSlice []interface{}) ([]*ibclient.Forwardingmemberserver, error) {
@@ -271,18 +276,12 @@ func validateNameServers(ftSlice []interface{}) ([]ibclient.NameServer, error) {
 	return nameServers, nil
 }
 
-func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
-	if err != nil {
-		return err
-	}
-
+func resourceZoneForwardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rec, err := searchObjectByRefOrInternalId("ZoneForward", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -291,91 +290,83 @@ func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
 	var zoneForward *ibclient.ZoneForward
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone forward record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal zone forward record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &zoneForward)
 	if err != nil {
-		return fmt.Errorf("failed getting zone forward record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting zone forward record : %s", err.Error()))
 	}
 
 	delete(zoneForward.Ea, eaNameForInternalId)
-	omittedEAs := omitEAs(zoneForward.Ea, extAttrs)
-
-	if omittedEAs != nil && len(omittedEAs) > 0 {
-		eaJSON, err := terraformSerializeEAs(omittedEAs)
-		if err != nil {
-			return err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return err
-		}
+	if err = terraformSetEAs(d, m, zoneForward.Ea); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("fqdn", zoneForward.Fqdn); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("external_ns_group", zoneForward.ExternalNsGroup); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if zoneForward.View != nil {
 		if err := d.Set("view", *zoneForward.View); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err := d.Set("zone_format", zoneForward.ZoneFormat); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if zoneForward.NsGroup != nil {
 		if err := d.Set("ns_group", *zoneForward.NsGroup); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("ns_group", ""); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if zoneForward.Comment != nil {
 		if err := d.Set("comment", *zoneForward.Comment); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if zoneForward.Disable != nil {
 		if err := d.Set("disable", *zoneForward.Disable); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if zoneForward.ForwardersOnly != nil {
 		if err := d.Set("forwarders_only", *zoneForward.ForwardersOnly); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if zoneForward.ForwardTo.NameServers != nil {
 		nsInterface := convertNullableNameServersToInterface(zoneForward.ForwardTo)
 		if err = d.Set("forward_to", nsInterface); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("forward_to", nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if zoneForward.ForwardingServers.Servers != nil {
 		fwServerInterface, _ := convertForwardingServersToInterface(zoneForward.ForwardingServers.Servers)
 		if err := d.Set("forwarding_servers", fwServerInterface); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := d.Set("forwarding_servers", nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -383,7 +374,7 @@ func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceZoneForwardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var updateSuccessful bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
@@ -397,6 +388,7 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 			prevForwardTo, _ := d.GetChange("forward_to")
 			prevForwardingServers, _ := d.GetChange("forwarding_servers")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
+			prevExtensibleAttrs, _ := d.GetChange("extensible_attributes")
 
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("disable", prevDisable.(bool))
@@ -406,6 +398,7 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("forward_to", prevForwardTo)
 			_ = d.Set("forwarding_servers", prevForwardingServers)
 			_ = d.Set("ext_attrs", prevExtAttrs.(string))
+			_ = d.Set("extensible_attributes", prevExtensibleAttrs)
 		}
 	}()
 
@@ -413,48 +406,28 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 	fsInterface, forwardingServersOk := d.GetOk("forwarding_servers")
 	ftInterface, forwardToOk := d.GetOk("forward_to")
 
-	if d.HasChange("internal_id") {
-		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
-	}
-	if d.HasChange("fqdn") {
-		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
-	}
-	if d.HasChange("view") {
-		return fmt.Errorf("changing the value of 'view' field is not allowed")
-	}
-	if d.HasChange("zone_format") {
-		return fmt.Errorf("changing the value of 'zone_format' field is not allowed")
-	}
-
 	var forwardTo []ibclient.NameServer
 	var nullFWT ibclient.NullableNameServers
 	if !externalNsGroupOk && !forwardToOk {
-		return fmt.Errorf("either external_ns_group or forward_to must be set")
+		return diag.FromErr(fmt.Errorf("either external_ns_group or forward_to must be set"))
 	} else if !forwardToOk {
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: []ibclient.NameServer{}}
 	} else {
 		ftSlice, ok := ftInterface.([]interface{})
 		if !ok {
-			return fmt.Errorf("forward_to is not a slice of Nameservers")
+			return diag.FromErr(fmt.Errorf("forward_to is not a slice of Nameservers"))
 		}
 		var err error
 		forwardTo, err = validateNameServers(ftSlice)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: forwardTo}
 	}
 
-	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
-
-	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	oldExtAttrs, newExtAttrs, err := terraformGetEAsChange(d, m)
 	if err != nil {
-		return err
-	}
-
-	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
-	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -470,8 +443,8 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("ZoneForward", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -479,11 +452,11 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone forward record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal zone forward record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &zf)
 	if err != nil {
-		return fmt.Errorf("failed getting zone forward record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed getting zone forward record : %s", err.Error()))
 	}
 
 	// If 'internal_id' is not set, then generate a new one and set it to the EA.
@@ -496,7 +469,7 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 
 	newExtAttrs, err = mergeEAs(zf.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	comment := d.Get("comment").(string)
@@ -521,11 +494,11 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 	if forwardingServersOk && d.HasChange("forwarding_servers") {
 		fsSlice, ok := fsInterface.([]interface{})
 		if !ok {
-			return fmt.Errorf("forwarding_servers is not a slice of Forwardingmemberserver pointers")
+			return diag.FromErr(fmt.Errorf("forwarding_servers is not a slice of Forwardingmemberserver pointers"))
 		}
 		forwardingServer, err := validateForwardingServers(fsSlice)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		nullFWS = &ibclient.NullableForwardingServers{IsNull: false, Servers: forwardingServer}
 	} else if !forwardingServersOk && d.HasChange("forwarding_servers") {
@@ -536,27 +509,26 @@ func resourceZoneForwardUpdate(d *schema.ResourceData, m interface{}) error {
 
 	zf, err = objMgr.UpdateZoneForward(d.Id(), comment, disable, newExtAttrs, nullFWT, forwardersOnly, nullFWS, nsGroup, externalNsGroup)
 	if err != nil {
-		return fmt.Errorf("Failed to update Zone Forward with %s, ", err.Error())
+		return diag.FromErr(fmt.Errorf("Failed to update Zone Forward with %s, ", err.Error()))
 	}
 
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("ref", zf.Ref); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(zf.Ref)
 
 	return nil
 }
 
-func resourceZoneForwardDelete(d *schema.ResourceData, m interface{}) error {
-	extAttrJSON := d.Get("ext_attrs").(string)
-	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+func resourceZoneForwardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	extAttrs, err := terraformGetEAs(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var tenantID string
@@ -571,8 +543,8 @@ func resourceZoneForwardDelete(d *schema.ResourceData, m interface{}) error {
 	rec, err := searchObjectByRefOrInternalId("ZoneForward", d, m)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
-			return ibclient.NewNotFoundError(fmt.Sprintf(
-				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err)))
 		} else {
 			d.SetId("")
 			return nil
@@ -582,24 +554,23 @@ func resourceZoneForwardDelete(d *schema.ResourceData, m interface{}) error {
 	var zf *ibclient.ZoneForward
 	recJson, err := json.Marshal(rec)
 	if err != nil {
-		return fmt.Errorf("failed to marshal zone forward record : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to marshal zone forward record : %s", err.Error()))
 	}
 	err = json.Unmarshal(recJson, &zf)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, err = objMgr.DeleteZoneForward(zf.Ref)
 	if err != nil {
-		return fmt.Errorf("failed to delete Zone Forward : %s", err.Error())
+		return diag.FromErr(fmt.Errorf("failed to delete Zone Forward : %s", err.Error()))
 	}
 
 	return nil
 }
 
-func resourceZoneForwardImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceZoneForwardImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 
-	extAttrJSON := d.Get("ext_attrs").(string)
-	_, err := terraformDeserializeEAs(extAttrJSON)
+	_, err := terraformGetEAs(d)
 	if err != nil {
 		return nil, err
 	}
@@ -612,14 +583,8 @@ func resourceZoneForwardImport(d *schema.ResourceData, m interface{}) ([]*schema
 		return nil, fmt.Errorf("failed getting zone forward record: %w", err)
 	}
 
-	if zf.Ea != nil && len(zf.Ea) > 0 {
-		eaJSON, err := terraformSerializeEAs(zf.Ea)
-		if err != nil {
-			return nil, err
-		}
-		if err = d.Set("ext_attrs", eaJSON); err != nil {
-			return nil, err
-		}
+	if err = terraformImportEAs(d, zf.Ea); err != nil {
+		return nil, err
 	}
 	delete(zf.Ea, eaNameForInternalId)
 
@@ -692,9 +657,8 @@ func resourceZoneForwardImport(d *schema.ResourceData, m interface{}) ([]*schema
 	d.SetId(zf.Ref)
 
 	// Update the resource with the EA Terraform Internal ID
-	err = resourceZoneForwardUpdate(d, m)
-	if err != nil {
-		return nil, err
+	if diags := resourceZoneForwardUpdate(ctx, d, m); diags.HasError() {
+		return nil, diagnosticsError(diags)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,136 @@
+package infoblox
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sort"
+	"strings"
+)
+
+// dataSourceArguments are the top-level fields of the filter-based data sources,
+// which are never overwritten by the attributes of a single matching object.
+var dataSourceArguments = map[string]bool{
+	"id":            true,
+	"filters":       true,
+	"filter":        true,
+	"page_size":     true,
+	"max_results":   true,
+	"truncated":     true,
+	"results":       true,
+	"expect_single": true,
+}
+
+// withSingleObjectMode adds the 'expect_single' argument to the schema of a data source,
+// along with the attributes of its results, which are set at the top level when a single object is expected.
+func withSingleObjectMode(r *schema.Resource) *schema.Resource {
+	r.Schema["expect_single"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "If true, exactly one object must match; its attributes are set at the top level " +
+			"of the data source, in addition to 'results', and its reference becomes the ID of the data source.",
+	}
+
+	results, ok := r.Schema["results"].Elem.(*schema.Resource)
+	if !ok {
+		return r
+	}
+	for name, s := range results.Schema {
+		if dataSourceArguments[name] {
+			continue
+		}
+		if _, exists := r.Schema[name]; exists {
+			continue
+		}
+		r.Schema[name] = computedSchema(s)
+	}
+
+	return r
+}
+
+// dataSourceResultsId derives the ID of a data source from the references of the matching objects,
+// so that the ID does not change as long as the same objects match.
+func dataSourceResultsId(refs []string) string {
+	sorted := append([]string{}, refs...)
+	sort.Strings(sorted)
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(sorted, "\n"))))
+}
+
+// setDataSourceResults sets the results of a data source along with its ID. In the single-object mode,
+// exactly one result is allowed; its attributes are set at the top level and its reference becomes the ID.
+// The attributes named after the arguments of the data source, which are given in addition to
+// the common ones, are not set at the top level.
+func setDataSourceResults(d *schema.ResourceData, results []interface{}, arguments ...string) error {
+	refs := make([]string, 0, len(results))
+	for _, r := range results {
+		if obj, ok := r.(map[string]interface{}); ok {
+			if ref, ok := obj["id"].(string); ok {
+				refs = append(refs, ref)
+			}
+		}
+	}
+
+	return setDataSourceResultRefs(d, results, refs, func(result interface{}) error {
+		obj, ok := result.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("the matching object has no reference")
+		}
+		for name, value := range obj {
+			if dataSourceArguments[name] || containsAny(arguments, []string{name}) {
+				continue
+			}
+			if err := d.Set(name, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// setDataSourceResultRefs sets the results of a data source, whose objects have the given references,
+// along with its ID. In the single-object mode, exactly one result is allowed: it is set by setSingle
+// and its reference becomes the ID.
+func setDataSourceResultRefs(
+	d *schema.ResourceData, results []interface{}, refs []string, setSingle func(result interface{}) error) error {
+
+	if err := d.Set("results", results); err != nil {
+		return err
+	}
+
+	if !d.Get("expect_single").(bool) {
+		d.SetId(dataSourceResultsId(refs))
+		return nil
+	}
+
+	// The data sources without paging have no 'truncated' attribute.
+	truncated, _ := d.Get("truncated").(bool)
+	if err := checkSingleResult(len(results), truncated); err != nil {
+		return err
+	}
+	if len(refs) != 1 {
+		return fmt.Errorf("the matching object has no reference")
+	}
+	if err := setSingle(results[0]); err != nil {
+		return err
+	}
+	d.SetId(refs[0])
+
+	return nil
+}
+
+// checkSingleResult checks that exactly one object matches the search of a data source.
+func checkSingleResult(count int, truncated bool) error {
+	switch {
+	case truncated:
+		return fmt.Errorf("expected exactly one matching object, found more than %d", count)
+	case count == 0:
+		return fmt.Errorf("expected exactly one matching object, found none")
+	case count > 1:
+		return fmt.Errorf("expected exactly one matching object, found %d", count)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package infoblox
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSetDataSourceResults(t *testing.T) {
+	viewA := map[string]interface{}{"id": "networkview/a:view_a/false", "name": "view_a", "comment": "first"}
+	viewB := map[string]interface{}{"id": "networkview/b:view_b/false", "name": "view_b", "comment": "second"}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetworkView().Schema, map[string]interface{}{})
+	if err := setDataSourceResults(d, []interface{}{viewA, viewB}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	id := d.Id()
+	if id != dataSourceResultsId([]string{"networkview/b:view_b/false", "networkview/a:view_a/false"}) {
+		t.Errorf("the ID must not depend on the order of the results, got '%s'", id)
+	}
+	if d.Get("name").(string) != "" {
+		t.Errorf("the attributes must not be set at the top level without 'expect_single'")
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceNetworkView().Schema, map[string]interface{}{"expect_single": true})
+	if err := setDataSourceResults(d, []interface{}{viewA}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Id() != "networkview/a:view_a/false" || d.Get("name").(string) != "view_a" || d.Get("comment").(string) != "first" {
+		t.Errorf("unexpected single object: ID '%s', name '%s', comment '%s'", d.Id(), d.Get("name"), d.Get("comment"))
+	}
+
+	errCases := []struct {
+		results []interface{}
+		err     string
+	}{
+		{[]interface{}{}, "found none"},
+		{[]interface{}{viewA, viewB}, "found 2"},
+	}
+	for _, tc := range errCases {
+		d = schema.TestResourceDataRaw(t, dataSourceNetworkView().Schema, map[string]interface{}{"expect_single": true})
+		err := setDataSourceResults(d, tc.results)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("expected error containing '%s', got %v", tc.err, err)
+		}
+	}
+}
+
+func TestSetDataSourceResultRefs(t *testing.T) {
+	ref := "record:caa/ZG5z:test.com/default"
+	result := `{"_ref":"record:caa/ZG5z:test.com/default","name":"test.com"}`
+	setResult := func(d *schema.ResourceData) func(interface{}) error {
+		return func(r interface{}) error { return d.Set("result", r) }
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceWapiQuery().Schema, map[string]interface{}{
+		"object_type":   "record:caa",
+		"expect_single": true,
+	})
+	if err := setDataSourceResultRefs(d, []interface{}{result}, []string{ref}, setResult(d)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Id() != ref || d.Get("result").(string) != result {
+		t.Errorf("unexpected single object: ID '%s', result '%s'", d.Id(), d.Get("result"))
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceWapiQuery().Schema, map[string]interface{}{
+		"object_type":   "record:caa",
+		"expect_single": true,
+	})
+	err := setDataSourceResultRefs(d, []interface{}{result, result}, []string{ref, ref}, setResult(d))
+	if err == nil || !strings.Contains(err.Error(), "expected exactly one matching object, found 2") {
+		t.Errorf("expected the error of the other data sources, got %v", err)
+	}
+}
+
+func TestCheckSingleResult(t *testing.T) {
+	if err := checkSingleResult(1, false); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := checkSingleResult(1, true); err == nil || !strings.Contains(err.Error(), "more than 1") {
+		t.Errorf("expected an error on truncated results, got %v", err)
+	}
+}
+
+func TestDataSourceResultsId(t *testing.T) {
+	id := dataSourceResultsId([]string{"record:a/b", "record:a/a"})
+	if id != dataSourceResultsId([]string{"record:a/a", "record:a/b"}) {
+		t.Errorf("the ID must not depend on the order of the references")
+	}
+	if id == dataSourceResultsId([]string{"record:a/a"}) || id == dataSourceResultsId([]string{"record:a/a", "record:a/c"}) {
+		t.Errorf("the ID must change when the matching objects change")
+	}
+	if len(id) != 64 {
+		t.Errorf("expected a SHA-256 hex digest, got '%s'", id)
+	}
+	if dataSourceResultsId(nil) != dataSourceResultsId([]string{}) {
+		t.Errorf("the ID of no results must be stable")
+	}
+}
+
+func TestSetDataSourceResultsArguments(t *testing.T) {
+	address := map[string]interface{}{
+		"id":         "ipv4address/Li5pcHY0X2FkZHJlc3MkMTAuMC4wLjEvMA:10.0.0.1",
+		"ip_address": "10.0.0.1",
+		"network":    "10.0.0.0/24",
+		"types":      []interface{}{"A", "HOST"},
+		"status":     "USED",
+	}
+	d := schema.TestResourceDataRaw(t, dataSourceIPv4Address().Schema, map[string]interface{}{
+		"network":       "10.0.0.0/24",
+		"types":         []interface{}{"A"},
+		"expect_single": true,
+	})
+	err := setDataSourceResults(d, []interface{}{address}, "network", "network_view", "ip_address", "status", "types", "usage")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Id() != address["id"] {
+		t.Errorf("expected the reference of the address to be the ID, got '%s'", d.Id())
+	}
+	// The arguments of the data source must be kept as they are configured.
+	if d.Get("types.#").(int) != 1 || d.Get("ip_address").(string) != "" {
+		t.Errorf("the arguments must not be overwritten, got types %v, ip_address '%s'", d.Get("types"), d.Get("ip_address"))
+	}
+
+	// The data sources without paging have no 'truncated' attribute.
+	d = schema.TestResourceDataRaw(t, dataSourceSearch().Schema, map[string]interface{}{
+		"address":       "10.0.0.1",
+		"expect_single": true,
+	})
+	found := map[string]interface{}{"id": "record:a/ZG5z:a.test.com/default", "object_type": "record:a", "name": "a.test.com"}
+	if err := setDataSourceResults(d, []interface{}{found}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Get("object_type").(string) != "record:a" || d.Get("name").(string) != "a.test.com" {
+		t.Errorf("unexpected single object: %v, %v", d.Get("object_type"), d.Get("name"))
+	}
+}
+
+func TestAccDataSourceNetworkViewExpectSingle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_network_view" "single" {
+						name = "tf_acc_test_single_view"
+						comment = "single view"
+					}
+
+					data "infoblox_network_view" "single" {
+						filters = {
+							name = infoblox_network_view.single.name
+						}
+						expect_single = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_network_view.single", "name", "tf_acc_test_single_view"),
+					resource.TestCheckResourceAttr("data.infoblox_network_view.single", "comment", "single view"),
+					resource.TestCheckResourceAttrPair("data.infoblox_network_view.single", "id", "infoblox_network_view.single", "ref"),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_network_view" "none" {
+						filters = {
+							name = "tf_acc_test_missing_view"
+						}
+						expect_single = true
+					}
+				`,
+				ExpectError: regexp.MustCompile("expected exactly one matching object, found none"),
+			},
+		},
+	})
+}
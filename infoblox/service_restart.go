@@ -0,0 +1,230 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	restartPolicyNever    = "never"
+	restartPolicyIfNeeded = "if_needed"
+	restartPolicyForce    = "force"
+
+	restartServiceDNS  = "DNS"
+	restartServiceDHCP = "DHCP"
+	restartServiceAll  = "ALL"
+
+	restartOptionIfNeeded = "RESTART_IF_NEEDED"
+	restartOptionForce    = "FORCE_RESTART"
+)
+
+var (
+	restartPolicies = []string{restartPolicyNever, restartPolicyIfNeeded, restartPolicyForce}
+	restartServices = []string{restartServiceAll, restartServiceDNS, restartServiceDHCP, "DHCPV4", "DHCPV6"}
+	restartModes    = []string{"GROUPED", "SEQUENTIAL", "SIMULTANEOUS"}
+
+	// restartOptions maps the restart policies to the WAPI restart options.
+	restartOptions = map[string]string{
+		restartPolicyIfNeeded: restartOptionIfNeeded,
+		restartPolicyForce:    restartOptionForce,
+	}
+)
+
+// restartServicesSchema returns the schema of the provider's 'restart_services' block,
+// which defines the restart policy of the services affected by the changes made by the provider.
+func restartServicesSchema() *schema.Schema {
+	policySchema := func(service string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      restartPolicyNever,
+			ValidateFunc: validation.StringInSlice(restartPolicies, false),
+			Description: fmt.Sprintf("The restart policy of the %s service: 'never', 'if_needed' "+
+				"(restart the members which require it) or 'force' (restart all the members).", service),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "The policy of restarting the services on the grid members after the changes " +
+			"made by the provider, which require a restart.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dns":  policySchema(restartServiceDNS),
+				"dhcp": policySchema(restartServiceDHCP),
+				"restart_group": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the restart group to restart the services on; all the grid members by default.",
+				},
+			},
+		},
+	}
+}
+
+// gridServiceRestart holds the arguments of the 'restartservices' function of the grid object.
+type gridServiceRestart struct {
+	RestartOption string
+	Services      []string
+	Groups        []string
+	Members       []string
+	Mode          string
+}
+
+func (r gridServiceRestart) args() map[string]interface{} {
+	args := map[string]interface{}{
+		"restart_option": r.RestartOption,
+		"services":       r.Services,
+	}
+	if len(r.Groups) > 0 {
+		args["groups"] = r.Groups
+	}
+	if len(r.Members) > 0 {
+		args["members"] = r.Members
+	}
+	if r.Mode != "" {
+		args["mode"] = r.Mode
+	}
+
+	return args
+}
+
+// getGridRef returns the reference of the grid object.
+func getGridRef(conn ibclient.IBConnector) (string, error) {
+	var res []struct {
+		Ref string `json:"_ref"`
+	}
+	if err := conn.GetObject(newWapiRawObject("grid", nil), "", ibclient.NewQueryParams(false, nil), &res); err != nil {
+		return "", err
+	}
+	if len(res) == 0 {
+		return "", fmt.Errorf("grid object not found")
+	}
+
+	return res[0].Ref, nil
+}
+
+// restartGridServices requests a restart of the services on the grid members.
+func restartGridServices(conn ibclient.IBConnector, restart gridServiceRestart) error {
+	gridRef, err := getGridRef(conn)
+	if err != nil {
+		return fmt.Errorf("failed to read the grid object: %w", err)
+	}
+	if err = callWapiFunction(conn, gridRef, "restartservices", restart.args(), nil); err != nil {
+		return fmt.Errorf("failed to restart %s services: %w", strings.Join(restart.Services, ", "), err)
+	}
+
+	return nil
+}
+
+// serviceRestarter restarts the services according to the provider's 'restart_services' policy.
+// The services are restarted by every operation of the resources which changes them, within the operation,
+// so that a failed restart is reported by the apply. The restarts are requested one at a time.
+type serviceRestarter struct {
+	policies map[string]string
+	group    string
+
+	mu sync.Mutex
+}
+
+// newServiceRestarter returns a restarter configured with the provider's 'restart_services' block,
+// or nil if no service is to be restarted.
+func newServiceRestarter(blocks []interface{}) *serviceRestarter {
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	cfg := blocks[0].(map[string]interface{})
+
+	r := &serviceRestarter{
+		policies: map[string]string{
+			restartServiceDNS:  cfg["dns"].(string),
+			restartServiceDHCP: cfg["dhcp"].(string),
+		},
+		group: cfg["restart_group"].(string),
+	}
+	for _, policy := range r.policies {
+		if policy != restartPolicyNever {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// restart restarts the services, one restart per restart option. The services with the 'never' policy are ignored.
+func (r *serviceRestarter) restart(conn ibclient.IBConnector, services []string) error {
+	byPolicy := make(map[string][]string)
+	for _, s := range services {
+		if policy, ok := r.policies[s]; ok && policy != restartPolicyNever {
+			byPolicy[policy] = append(byPolicy[policy], s)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, policy := range []string{restartPolicyForce, restartPolicyIfNeeded} {
+		services := byPolicy[policy]
+		if len(services) == 0 {
+			continue
+		}
+		sort.Strings(services)
+
+		restart := gridServiceRestart{RestartOption: restartOptions[policy], Services: services}
+		if r.group != "" {
+			restart.Groups = []string{r.group}
+		}
+		if err := restartGridServices(conn, restart); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withServiceRestart makes the resource restart the given services according to the provider's
+// 'restart_services' policy, after the resource is created, updated or deleted. A failed restart is reported
+// as a warning: the object is changed already, and an error would make Terraform replace the created object.
+func withServiceRestart(r *schema.Resource, services ...string) *schema.Resource {
+	wrap := func(
+		op func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if op == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			diags := op(ctx, d, m)
+			if diags.HasError() {
+				return diags
+			}
+			if meta, ok := m.(*providerMeta); ok && meta.restarter != nil {
+				if err := meta.restarter.restart(meta, services); err != nil {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Restart of the services failed",
+						Detail: fmt.Sprintf("The changes are applied, but they may not take effect "+
+							"until the services are restarted: %s", err),
+					})
+				}
+			}
+
+			return diags
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+
+	return r
+}
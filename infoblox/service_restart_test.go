@@ -0,0 +1,141 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// testRestartConnector records the WAPI function calls.
+type testRestartConnector struct {
+	ibclient.IBConnector
+
+	mu    sync.Mutex
+	calls []testFunctionCall
+}
+
+type testFunctionCall struct {
+	ref      string
+	function string
+	args     map[string]interface{}
+}
+
+func (c *testRestartConnector) GetObject(
+	obj ibclient.IBObject, ref string, qp *ibclient.QueryParams, res interface{}) error {
+
+	return json.Unmarshal([]byte(`[{"_ref": "grid/b25lLmNsdXN0ZXIkMA:Infoblox"}]`), res)
+}
+
+func (c *testRestartConnector) callFunction(ref, function string, args map[string]interface{}, res interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, testFunctionCall{ref: ref, function: function, args: args})
+
+	return nil
+}
+
+func restartServicesBlock(dns, dhcp, group string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"dns":           dns,
+			"dhcp":          dhcp,
+			"restart_group": group,
+		},
+	}
+}
+
+func TestNewServiceRestarter(t *testing.T) {
+	if r := newServiceRestarter(nil); r != nil {
+		t.Errorf("expected no restarter without 'restart_services' block")
+	}
+	if r := newServiceRestarter(restartServicesBlock(restartPolicyNever, restartPolicyNever, "")); r != nil {
+		t.Errorf("expected no restarter when no service is to be restarted")
+	}
+	if r := newServiceRestarter(restartServicesBlock(restartPolicyNever, restartPolicyIfNeeded, "")); r == nil {
+		t.Errorf("expected a restarter when DHCP service is to be restarted")
+	}
+}
+
+func TestServiceRestarterRestart(t *testing.T) {
+	conn := &testRestartConnector{}
+	r := newServiceRestarter(restartServicesBlock(restartPolicyIfNeeded, restartPolicyForce, "group1"))
+
+	if err := r.restart(conn, []string{restartServiceDNS, restartServiceDHCP}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(conn.calls) != 2 {
+		t.Fatalf("expected a single restart per restart option, got %d calls", len(conn.calls))
+	}
+	for _, call := range conn.calls {
+		if call.ref != "grid/b25lLmNsdXN0ZXIkMA:Infoblox" {
+			t.Errorf("expected the function of the grid object to be called, got '%s'", call.ref)
+		}
+		if call.function != "restartservices" {
+			t.Errorf("expected 'restartservices' function, got '%s'", call.function)
+		}
+		data := call.args
+		services := data["services"].([]string)
+		if len(services) != 1 {
+			t.Fatalf("expected a single service per restart option, got %v", services)
+		}
+		expectedOption := restartOptionIfNeeded
+		if services[0] == restartServiceDHCP {
+			expectedOption = restartOptionForce
+		}
+		if data["restart_option"] != expectedOption {
+			t.Errorf("expected '%s' restart option for %s, got '%v'", expectedOption, services[0], data["restart_option"])
+		}
+		if groups := data["groups"].([]string); len(groups) != 1 || groups[0] != "group1" {
+			t.Errorf("expected the restart of 'group1' restart group, got %v", groups)
+		}
+	}
+}
+
+func TestServiceRestarterNeverPolicy(t *testing.T) {
+	conn := &testRestartConnector{}
+	r := newServiceRestarter(restartServicesBlock(restartPolicyNever, restartPolicyForce, ""))
+
+	if err := r.restart(conn, []string{restartServiceDNS}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(conn.calls) != 0 {
+		t.Errorf("expected no restart of a service with 'never' policy, got %d calls", len(conn.calls))
+	}
+}
+
+func TestWithServiceRestart(t *testing.T) {
+	requestor := &testRequestor{response: `[{"_ref": "grid/b25lLmNsdXN0ZXIkMA:Infoblox"}]`}
+	conn := newTestWapiConnector(requestor)
+	meta := &providerMeta{
+		IBConnector: conn,
+		connector:   conn,
+		restarter:   newServiceRestarter(restartServicesBlock(restartPolicyIfNeeded, restartPolicyNever, "")),
+	}
+	r := withServiceRestart(&schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			d.SetId("zone_auth/ZG5zLnpvbmUkLl9kZWZhdWx0LmNvbS5leGFtcGxl:example.com/default")
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}, restartServiceDNS)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	if diags := r.CreateContext(context.Background(), d, meta); len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(requestor.requests) != 2 || requestor.requests[1].URL.Query().Get("_function") != "restartservices" {
+		t.Fatalf("expected the service to be restarted within the operation, got %d requests", len(requestor.requests))
+	}
+
+	requestor.err = errors.New("restart is in progress")
+	diags := r.CreateContext(context.Background(), d, meta)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || diags.HasError() {
+		t.Errorf("expected the failed restart to be reported as a warning, got %v", diags)
+	}
+}
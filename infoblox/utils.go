@@ -0,0 +1,14 @@
+package infoblox
+
+// containsAny checks whether the list contains any of the values.
+func containsAny(list []string, values []string) bool {
+	for _, l := range list {
+		for _, v := range values {
+			if l == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
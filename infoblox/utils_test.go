@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"testing"
 )
 
 const (
@@ -135,3 +136,12 @@ func validateEAs(actualEAs, expectedEAs map[string]interface{}) error {
 
 	return nil
 }
+
+func TestContainsAny(t *testing.T) {
+	if !containsAny([]string{"A", "HOST"}, []string{"PTR", "HOST"}) {
+		t.Errorf("expected the common value to be found")
+	}
+	if containsAny([]string{"A"}, []string{"PTR"}) || containsAny(nil, []string{"A"}) {
+		t.Errorf("expected no common values")
+	}
+}
@@ -0,0 +1,277 @@
+package infoblox
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"time"
+)
+
+// The kinds of the WAPI errors, which may be checked using errors.Is.
+var (
+	errWapiConflict   = errors.New("conflict")
+	errWapiValidation = errors.New("validation error")
+	errWapiAuth       = errors.New("authentication failure")
+	errWapiPermission = errors.New("permission denied")
+	errWapiNotFound   = errors.New("not found")
+	errWapiServerBusy = errors.New("server busy")
+)
+
+// wapiErrorHints are the details added to the diagnostics of the WAPI errors of each kind.
+var wapiErrorHints = map[error]string{
+	errWapiConflict: "The object conflicts with an existing NIOS object: import the existing object " +
+		"or change the conflicting arguments.",
+	errWapiValidation: "NIOS rejected the request: check the values of the arguments.",
+	errWapiAuth:       "Check the username and the password specified for the provider.",
+	errWapiPermission: "The NIOS user of the provider does not have the permissions required for the operation.",
+	errWapiNotFound:   "The object does not exist in NIOS: it may have been deleted outside of Terraform.",
+	errWapiServerBusy: "NIOS is busy: retry the operation later.",
+}
+
+// wapiError is an error returned by WAPI, which is decoded from the JSON body of the response.
+type wapiError struct {
+	StatusCode int
+	Code       string `json:"code"`
+	Message    string `json:"Error"`
+	Text       string `json:"text"`
+
+	// Attribute is the argument of the resource the error is caused by, if it is known to the caller.
+	Attribute string `json:"-"`
+}
+
+// newWapiError decodes the body of an unsuccessful WAPI response. The bodies other than WAPI errors,
+// like the HTML pages of the authentication failures, are kept as the text of the error,
+// unless they are HTML pages.
+func newWapiError(status int, body []byte) *wapiError {
+	res := &wapiError{StatusCode: status}
+	if err := json.Unmarshal(body, res); err != nil || res.Text == "" && res.Message == "" {
+		res.Code, res.Message = "", ""
+		res.Text = http.StatusText(status)
+		if contents := strings.TrimSpace(string(body)); contents != "" && !strings.HasPrefix(contents, "<") {
+			res.Text = contents
+		}
+	}
+
+	return res
+}
+
+func (e *wapiError) Error() string {
+	text := e.Text
+	if text == "" {
+		text = e.Message
+	}
+	if e.Code == "" {
+		return fmt.Sprintf("WAPI request error: %d: %s", e.StatusCode, text)
+	}
+
+	return fmt.Sprintf("WAPI request error: %d (%s): %s", e.StatusCode, e.Code, text)
+}
+
+// Unwrap returns the kind of the error, so that it may be checked using errors.Is.
+func (e *wapiError) Unwrap() error {
+	return wapiErrorKind(e.StatusCode, e.Code)
+}
+
+// wapiErrorKind classifies a WAPI error by its HTTP status and its WAPI code, such as 'Client.Ibap.Data.Conflict'.
+func wapiErrorKind(status int, code string) error {
+	switch {
+	case status == http.StatusUnauthorized:
+		return errWapiAuth
+	case status == http.StatusForbidden || strings.Contains(code, "Permission"):
+		return errWapiPermission
+	case status == http.StatusNotFound || strings.HasSuffix(code, ".NotFound"):
+		return errWapiNotFound
+	case status == http.StatusConflict || strings.HasPrefix(code, "Client.Ibap.Data.Conflict"):
+		return errWapiConflict
+	case status == http.StatusServiceUnavailable || status == http.StatusTooManyRequests ||
+		strings.HasPrefix(code, "Server.Ibap.Busy"):
+		return errWapiServerBusy
+	case status == http.StatusBadRequest || strings.HasPrefix(code, "Client.Ibap."):
+		return errWapiValidation
+	}
+
+	return nil
+}
+
+// withWapiErrorAttribute attributes the WAPI error wrapped by err, if any, to the argument of the resource,
+// which the failed request is known to be made for.
+func withWapiErrorAttribute(err error, attr string) error {
+	var wErr *wapiError
+	if errors.As(err, &wErr) {
+		wErr.Attribute = attr
+	}
+
+	return err
+}
+
+// field returns the WAPI field the error is reported for, if the text of the error names it,
+// like the validation errors 'Invalid value for ttl: "-1": ...'.
+func (e *wapiError) field() string {
+	rest, ok := strings.CutPrefix(e.Text, "Invalid value for ")
+	if !ok {
+		return ""
+	}
+	if i := strings.IndexAny(rest, ": ("); i >= 0 {
+		rest = rest[:i]
+	}
+
+	return rest
+}
+
+// commonWapiFields maps the arguments, which most resources have, to the WAPI fields.
+var commonWapiFields = map[string]string{
+	"comment":   "comment",
+	"ttl":       "ttl",
+	"ext_attrs": "extattrs",
+	"disable":   "disable",
+}
+
+// withWapiFieldAttributes attributes the WAPI errors of the creation and the update of the resource,
+// which name a WAPI field, to the argument the field is set from. The fields map the arguments to the WAPI fields,
+// like the natural keys do; the common arguments of the resource are mapped as well.
+func withWapiFieldAttributes(r *schema.Resource, fields map[string]string) *schema.Resource {
+	attrs := make(map[string]string, len(fields)+len(commonWapiFields))
+	for attr, field := range commonWapiFields {
+		if _, ok := r.Schema[attr]; ok {
+			attrs[field] = attr
+		}
+	}
+	for attr, field := range fields {
+		attrs[field] = attr
+	}
+
+	wrap := func(
+		op func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if op == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			diags := op(ctx, d, m)
+			if !diags.HasError() {
+				return diags
+			}
+			for _, e := range wapiErrorsFromMeta(m) {
+				if attr, ok := attrs[e.field()]; ok && e.Attribute == "" {
+					e.Attribute = attr
+				}
+			}
+
+			return diags
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+
+	return r
+}
+
+// wapiHttpRequestor sends the WAPI requests like the go-client's requestor does, but returns the errors
+// of the unsuccessful responses as wapiError, decoded from their bodies. 'Not found' responses are returned
+// as the go-client's NotFoundError, since the resources check the errors by this type.
+type wapiHttpRequestor struct {
+	client *http.Client
+}
+
+func (r *wapiHttpRequestor) Init(_ ibclient.AuthConfig, transportConfig ibclient.TransportConfig) {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: !transportConfig.SslVerify,
+			Renegotiation:      tls.RenegotiateOnceAsClient,
+		},
+		MaxIdleConnsPerHost: transportConfig.HttpPoolConnections,
+		Proxy:               http.ProxyFromEnvironment,
+	}
+	if transportConfig.ProxyUrl != nil {
+		transport.Proxy = http.ProxyURL(transportConfig.ProxyUrl)
+	}
+	// The jar keeps the session cookie of WAPI, so that the credentials are not checked for every request.
+	jar, _ := cookiejar.New(nil)
+
+	r.client = &http.Client{
+		Jar:       jar,
+		Transport: transport,
+		Timeout:   transportConfig.HttpRequestTimeout * time.Second,
+	}
+}
+
+func (r *wapiHttpRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAPI response: %w", err)
+	}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated && req.Method == http.MethodPost {
+		return body, nil
+	}
+
+	wErr := newWapiError(resp.StatusCode, body)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ibclient.NewNotFoundError(wErr.Error())
+	}
+
+	return nil, wErr
+}
+
+// hasWapiError checks whether any of the error diagnostics is caused by a WAPI error of the given kind,
+// among the WAPI errors received during the operation.
+func hasWapiError(diags diag.Diagnostics, errs []*wapiError, kind error) bool {
+	for _, e := range errs {
+		if errors.Is(e, kind) && findWapiErrorDiagnostic(diags, e) >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findWapiErrorDiagnostic returns the index of the error diagnostic, which reports the WAPI error, or -1.
+func findWapiErrorDiagnostic(diags diag.Diagnostics, e *wapiError) int {
+	msg := e.Error()
+	for i, d := range diags {
+		if d.Severity == diag.Error && strings.Contains(d.Summary, msg) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// withWapiErrorDetails adds the hints to the error diagnostics reporting the WAPI errors received
+// during the operation, and attributes them to the arguments of the resource the errors are known to refer to.
+func withWapiErrorDetails(diags diag.Diagnostics, errs []*wapiError) diag.Diagnostics {
+	for _, e := range errs {
+		i := findWapiErrorDiagnostic(diags, e)
+		if i < 0 {
+			continue
+		}
+		d := &diags[i]
+		for kind, hint := range wapiErrorHints {
+			if errors.Is(e, kind) && d.Detail == "" {
+				d.Detail = hint
+			}
+		}
+		if e.Attribute != "" && d.AttributePath == nil {
+			d.AttributePath = cty.GetAttrPath(e.Attribute)
+		}
+	}
+
+	return diags
+}
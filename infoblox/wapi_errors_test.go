@@ -0,0 +1,164 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func TestNewWapiError(t *testing.T) {
+	cases := []struct {
+		status  int
+		body    string
+		kind    error
+		message string
+	}{
+		{
+			http.StatusBadRequest,
+			`{ "Error": "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:The record 'a.test.com' already exists.)",` +
+				` "code": "Client.Ibap.Data.Conflict", "text": "The record 'a.test.com' already exists."}` + "\n",
+			errWapiConflict,
+			"WAPI request error: 400 (Client.Ibap.Data.Conflict): The record 'a.test.com' already exists.",
+		},
+		{
+			http.StatusBadRequest,
+			`{ "Error": "AdmConProtoError: Invalid value for ttl: \"-1\": Invalid value, must be between 0 and 4294967295",` +
+				` "code": "Client.Ibap.Proto", "text": "Invalid value for ttl: \"-1\": Invalid value, must be between 0 and 4294967295"}`,
+			errWapiValidation,
+			`WAPI request error: 400 (Client.Ibap.Proto): Invalid value for ttl: "-1": Invalid value, must be between 0 and 4294967295`,
+		},
+		{
+			http.StatusUnauthorized,
+			"<html><body>Authorization Required</body></html>\n",
+			errWapiAuth,
+			"WAPI request error: 401: Unauthorized",
+		},
+		{
+			http.StatusForbidden,
+			`{"Error": "AdmConProtoError: Permission denied", "code": "Client.Ibap.Proto", "text": "Permission denied"}`,
+			errWapiPermission,
+			"WAPI request error: 403 (Client.Ibap.Proto): Permission denied",
+		},
+		{
+			http.StatusServiceUnavailable,
+			"The server is busy\n",
+			errWapiServerBusy,
+			"WAPI request error: 503: The server is busy",
+		},
+	}
+
+	for i, tc := range cases {
+		err := newWapiError(tc.status, []byte(tc.body))
+		if !errors.Is(err, tc.kind) {
+			t.Errorf("case %d: expected the error of kind '%s', got %v", i, tc.kind, err)
+		}
+		if err.Error() != tc.message {
+			t.Errorf("case %d: expected message %q, got %q", i, tc.message, err.Error())
+		}
+	}
+}
+
+func TestWapiHttpRequestor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/conflict":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"Error": "AdmConDataError", "code": "Client.Ibap.Data.Conflict", "text": "Exists."}`))
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"Error": "AdmConDataNotFoundError", "code": "Client.Ibap.Data.NotFound", "text": "No object."}`))
+		default:
+			_, _ = w.Write([]byte(`"record:a/ZG5z:a.test.com/default"`))
+		}
+	}))
+	defer server.Close()
+
+	requestor := &wapiHttpRequestor{}
+	requestor.Init(ibclient.AuthConfig{}, ibclient.TransportConfig{HttpRequestTimeout: 5})
+	send := func(path string) ([]byte, error) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return requestor.SendRequest(req)
+	}
+
+	if res, err := send("/record:a"); err != nil || string(res) != `"record:a/ZG5z:a.test.com/default"` {
+		t.Errorf("expected the body of the response, got %q, %v", res, err)
+	}
+
+	_, err := send("/conflict")
+	var wErr *wapiError
+	if !errors.As(fmt.Errorf("creation failed: %w", err), &wErr) || !errors.Is(wErr, errWapiConflict) || wErr.Text != "Exists." {
+		t.Errorf("expected the conflict decoded from the response, got %v", err)
+	}
+
+	if _, err = send("/missing"); !isNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestWithWapiErrorDetails(t *testing.T) {
+	ttlErr := newWapiError(http.StatusBadRequest,
+		[]byte(`{"Error": "AdmConProtoError: Invalid value for ttl", "code": "Client.Ibap.Proto", "text": "Invalid value for ttl: \"-1\""}`))
+	vlanErr := newWapiError(http.StatusBadRequest,
+		[]byte(`{"Error": "AdmConDataError: VLAN is in use", "code": "Client.Ibap.Data", "text": "VLAN is in use"}`))
+	err := withWapiErrorAttribute(fmt.Errorf("assignment of VLANs failed: %w", vlanErr), "vlans")
+
+	diags := withWapiErrorDetails(diag.Diagnostics{
+		diag.FromErr(fmt.Errorf("creation of A-record failed: %w", ttlErr))[0],
+		diag.FromErr(err)[0],
+		diag.FromErr(errors.New("some other error"))[0],
+	}, []*wapiError{ttlErr, vlanErr})
+
+	if diags[0].AttributePath != nil || diags[0].Detail != wapiErrorHints[errWapiValidation] {
+		t.Errorf("expected the hint only, got %+v", diags[0])
+	}
+	if !diags[1].AttributePath.Equals(cty.GetAttrPath("vlans")) || diags[1].Detail != wapiErrorHints[errWapiValidation] {
+		t.Errorf("expected the error to be attributed to 'vlans', got %+v", diags[1])
+	}
+	if diags[2].AttributePath != nil || diags[2].Detail != "" {
+		t.Errorf("expected other errors to be kept as is, got %+v", diags[2])
+	}
+
+	if !hasWapiError(diags, []*wapiError{ttlErr}, errWapiValidation) || hasWapiError(diags, []*wapiError{ttlErr}, errWapiConflict) {
+		t.Errorf("expected the validation error only to be found")
+	}
+}
+
+func TestWithWapiFieldAttributes(t *testing.T) {
+	ttlErr := newWapiError(http.StatusBadRequest,
+		[]byte(`{"Error": "AdmConProtoError: Invalid value for ttl", "code": "Client.Ibap.Proto", "text": "Invalid value for ttl: \"-1\""}`))
+	addrErr := newWapiError(http.StatusBadRequest,
+		[]byte(`{"Error": "AdmConProtoError", "code": "Client.Ibap.Proto", "text": "Invalid value for ipv4addr (\"10.0.0\")"}`))
+	otherErr := newWapiError(http.StatusBadRequest,
+		[]byte(`{"Error": "AdmConProtoError", "code": "Client.Ibap.Proto", "text": "Invalid value for use_ttl: \"x\""}`))
+	if ttlErr.field() != "ttl" || addrErr.field() != "ipv4addr" || otherErr.field() != "use_ttl" {
+		t.Fatalf("expected the fields named by the errors, got '%s', '%s', '%s'", ttlErr.field(), addrErr.field(), otherErr.field())
+	}
+
+	r := resourceARecord()
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(fmt.Errorf("creation of A-record failed: %w", ttlErr))
+	}
+	r = withWapiFieldAttributes(r, aRecordNaturalKey)
+	meta := &providerMeta{connector: &wapiConnector{wapiErrors: &[]*wapiError{ttlErr, addrErr, otherErr}}}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"fqdn": "a.test.com", "ip_addr": "10.0.0"})
+	diags := withWapiErrorDetails(r.CreateContext(context.Background(), d, meta), wapiErrorsFromMeta(meta))
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath("ttl")) {
+		t.Errorf("expected the error to be attributed to 'ttl', got %+v", diags)
+	}
+	if addrErr.Attribute != "ip_addr" || otherErr.Attribute != "" {
+		t.Errorf("expected the errors to be attributed to the arguments setting the fields, got '%s', '%s'",
+			addrErr.Attribute, otherErr.Attribute)
+	}
+}